@@ -0,0 +1,329 @@
+/*
+ * File Created: Monday, 19th October 2026 12:36:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// amountScale is number of minor units in one rupiah, DurianPay amounts have 2 decimal places.
+const amountScale = 100
+
+// ErrInvalidAmount is returned when amount cannot be parsed.
+var ErrInvalidAmount = errors.New("durianpay: invalid amount")
+
+// Amount is decimal-safe money amount, stored as minor units (1/100 rupiah).
+// The zero value is zero rupiah, use NewAmount or ParseAmount to create Amount.
+//
+// DurianPay sends amounts as string ("10000.00") in most APIs and as number in some (Virtual Account, MDR Fees),
+// Amount accepts both when unmarshalling and is marshalled as string, ex: "10000" or "10000.50".
+type Amount struct {
+	units int64
+}
+
+// NewAmount returns Amount of rupiah.
+func NewAmount(rupiah int64) Amount {
+	return Amount{rupiah * amountScale}
+}
+
+// AmountFromMinorUnits returns Amount of minor units, ex: AmountFromMinorUnits(1050) is 10.50.
+func AmountFromMinorUnits(units int64) Amount {
+	return Amount{units}
+}
+
+// ParseAmount parses decimal amount, ex: "10000", "10000.00" or "-2500.5".
+// Empty string is parsed as zero. Decimals beyond 2 places are rounded half away from zero.
+func ParseAmount(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return Amount{}, nil
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	whole, fraction, hasFraction := strings.Cut(s, ".")
+	if (whole == "" && fraction == "") || (hasFraction && fraction == "") || !isDigits(whole) || !isDigits(fraction) {
+		return Amount{}, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+
+	var units int64
+	for _, c := range whole {
+		if units > (math.MaxInt64-9)/10/amountScale {
+			return Amount{}, fmt.Errorf("%w: %q overflows", ErrInvalidAmount, s)
+		}
+		units = units*10 + int64(c-'0')
+	}
+	units *= amountScale
+
+	for i, scale := 0, int64(amountScale/10); i < len(fraction) && scale > 0; i, scale = i+1, scale/10 {
+		units += int64(fraction[i]-'0') * scale
+	}
+
+	// Round half away from zero on the first dropped decimal
+	if len(fraction) > 2 && fraction[2] >= '5' {
+		units++
+	}
+
+	if negative {
+		units = -units
+	}
+
+	return Amount{units}, nil
+}
+
+// MustParseAmount is like ParseAmount but panics if s cannot be parsed.
+func MustParseAmount(s string) Amount {
+	a, err := ParseAmount(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return a
+}
+
+// AmountFromFloat returns Amount of f rounded to 2 decimal places.
+// Only use it for values which are already float, ex: from other libraries.
+func AmountFromFloat(f float64) Amount {
+	return Amount{int64(math.Round(f * amountScale))}
+}
+
+// MinorUnits returns amount in minor units (1/100 rupiah).
+func (a Amount) MinorUnits() int64 {
+	return a.units
+}
+
+// Rupiah returns whole rupiah part of amount, truncated toward zero.
+func (a Amount) Rupiah() int64 {
+	return a.units / amountScale
+}
+
+// Float64 returns amount as float64, it can lose precision and should only be used for display or statistics.
+func (a Amount) Float64() float64 {
+	return float64(a.units) / amountScale
+}
+
+// IsZero reports whether amount is zero.
+func (a Amount) IsZero() bool {
+	return a.units == 0
+}
+
+// IsNegative reports whether amount is less than zero.
+func (a Amount) IsNegative() bool {
+	return a.units < 0
+}
+
+// Add returns a + b.
+func (a Amount) Add(b Amount) Amount {
+	return Amount{a.units + b.units}
+}
+
+// Sub returns a - b.
+func (a Amount) Sub(b Amount) Amount {
+	return Amount{a.units - b.units}
+}
+
+// Mul returns a * n.
+func (a Amount) Mul(n int64) Amount {
+	return Amount{a.units * n}
+}
+
+// Div returns a / n rounded half away from zero. It panics if n is zero.
+func (a Amount) Div(n int64) Amount {
+	return Amount{divRound(a.units, n)}
+}
+
+// MulRatio returns a * num / den rounded half away from zero, ex: a.MulRatio(7, 1000) is 0.7% of a.
+func (a Amount) MulRatio(num, den int64) Amount {
+	return Amount{divRound(a.units*num, den)}
+}
+
+// Neg returns -a.
+func (a Amount) Neg() Amount {
+	return Amount{-a.units}
+}
+
+// Abs returns absolute value of a.
+func (a Amount) Abs() Amount {
+	if a.units < 0 {
+		return Amount{-a.units}
+	}
+
+	return a
+}
+
+// Cmp compares a and b and returns -1 if a < b, 0 if a == b and +1 if a > b.
+func (a Amount) Cmp(b Amount) int {
+	switch {
+	case a.units < b.units:
+		return -1
+	case a.units > b.units:
+		return 1
+	}
+
+	return 0
+}
+
+// GreaterThan reports whether a > b.
+func (a Amount) GreaterThan(b Amount) bool {
+	return a.units > b.units
+}
+
+// LessThan reports whether a < b.
+func (a Amount) LessThan(b Amount) bool {
+	return a.units < b.units
+}
+
+// String returns amount with 2 decimal places like DurianPay responses, ex: "10000.00".
+func (a Amount) String() string {
+	sign := ""
+	units := a.units
+	if units < 0 {
+		sign = "-"
+	}
+
+	whole, fraction := splitUnits(units)
+
+	return fmt.Sprintf("%s%d.%02d", sign, whole, fraction)
+}
+
+// Compact returns amount without decimals when it is whole rupiah, ex: "10000" or "10000.50".
+func (a Amount) Compact() string {
+	if a.units%amountScale == 0 {
+		return strconv.FormatInt(a.Rupiah(), 10)
+	}
+
+	return a.String()
+}
+
+// Display returns amount formatted for Indonesian users, ex: "Rp 10.000" or "Rp 10.000,50".
+func (a Amount) Display() string {
+	units := a.units
+	whole, fraction := splitUnits(units)
+
+	digits := strconv.FormatUint(whole, 10)
+
+	var b strings.Builder
+	if units < 0 {
+		b.WriteString("-")
+	}
+	b.WriteString("Rp ")
+	for i, c := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteByte('.')
+		}
+		b.WriteRune(c)
+	}
+
+	if fraction != 0 {
+		fmt.Fprintf(&b, ",%02d", fraction)
+	}
+
+	return b.String()
+}
+
+// Number returns amount as json.Number, for APIs which expect amount as number (ex: Virtual Account).
+func (a Amount) Number() json.Number {
+	return json.Number(a.Compact())
+}
+
+// MarshalJSON implements json.Marshaler, amount is marshalled as string, see Compact.
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Compact())
+}
+
+// EncodeValues implements query.Encoder of go-querystring, amount is encoded like Compact.
+func (a Amount) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, a.Compact())
+
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts string, number & null.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	s := string(data)
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+	}
+
+	parsed, err := ParseAmount(s)
+	if err != nil {
+		// Number in exponent notation, ex: 1e+06
+		f, ferr := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if ferr != nil {
+			return err
+		}
+		parsed = AmountFromFloat(f)
+	}
+
+	*a = parsed
+
+	return nil
+}
+
+// splitUnits returns absolute whole & fraction part of units.
+func splitUnits(units int64) (uint64, uint64) {
+	abs := uint64(units)
+	if units < 0 {
+		abs = uint64(-units)
+	}
+
+	return abs / amountScale, abs % amountScale
+}
+
+// divRound returns a / b rounded half away from zero.
+func divRound(a, b int64) int64 {
+	q, r := a/b, a%b
+	if r < 0 {
+		r = -r
+	}
+
+	absB := b
+	if absB < 0 {
+		absB = -absB
+	}
+
+	if 2*r >= absB {
+		if (a < 0) != (b < 0) {
+			q--
+		} else {
+			q++
+		}
+	}
+
+	return q
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
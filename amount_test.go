@@ -0,0 +1,193 @@
+/*
+ * File Created: Monday, 19th October 2026 12:36:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+func TestParseAmount(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       string
+		want    int64
+		wantErr bool
+	}{
+		{name: "Whole", s: "10000", want: 1000000},
+		{name: "Two decimals", s: "10000.67", want: 1000067},
+		{name: "One decimal", s: "10000.5", want: 1000050},
+		{name: "Negative", s: "-2500.25", want: -250025},
+		{name: "Empty", s: "", want: 0},
+		{name: "Round half up", s: "86.415", want: 8642},
+		{name: "Round down", s: "86.4149", want: 8641},
+		{name: "Round negative away from zero", s: "-0.005", want: -1},
+		{name: "Leading dot", s: ".5", want: 50},
+		{name: "Invalid", s: "10.000,00", wantErr: true},
+		{name: "Trailing dot", s: "10.", wantErr: true},
+		{name: "Only sign", s: "-", wantErr: true},
+		{name: "Overflow", s: "99999999999999999999", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseAmount(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAmount() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil && !errors.Is(err, ErrInvalidAmount) {
+				t.Errorf("ParseAmount() error = %v, want ErrInvalidAmount", err)
+			}
+
+			if got.MinorUnits() != tt.want {
+				t.Errorf("ParseAmount() = %v, want %v", got.MinorUnits(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAmount_Format(t *testing.T) {
+	tests := []struct {
+		amount      Amount
+		wantString  string
+		wantCompact string
+		wantDisplay string
+	}{
+		{amount: NewAmount(10000), wantString: "10000.00", wantCompact: "10000", wantDisplay: "Rp 10.000"},
+		{amount: MustParseAmount("1234567.5"), wantString: "1234567.50", wantCompact: "1234567.50", wantDisplay: "Rp 1.234.567,50"},
+		{amount: MustParseAmount("-0.05"), wantString: "-0.05", wantCompact: "-0.05", wantDisplay: "-Rp 0,05"},
+		{amount: Amount{}, wantString: "0.00", wantCompact: "0", wantDisplay: "Rp 0"},
+		{amount: NewAmount(999), wantString: "999.00", wantCompact: "999", wantDisplay: "Rp 999"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.wantString, func(t *testing.T) {
+			if got := tt.amount.String(); got != tt.wantString {
+				t.Errorf("Amount.String() = %v, want %v", got, tt.wantString)
+			}
+
+			if got := tt.amount.Compact(); got != tt.wantCompact {
+				t.Errorf("Amount.Compact() = %v, want %v", got, tt.wantCompact)
+			}
+
+			if got := tt.amount.Display(); got != tt.wantDisplay {
+				t.Errorf("Amount.Display() = %v, want %v", got, tt.wantDisplay)
+			}
+		})
+	}
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	a := MustParseAmount("10000.10")
+	b := MustParseAmount("0.20")
+
+	if got := a.Add(b); got != MustParseAmount("10000.30") {
+		t.Errorf("Amount.Add() = %v", got)
+	}
+
+	if got := b.Sub(a); got != MustParseAmount("-9999.90") || !got.IsNegative() {
+		t.Errorf("Amount.Sub() = %v", got)
+	}
+
+	if got := b.Mul(3); got != MustParseAmount("0.60") {
+		t.Errorf("Amount.Mul() = %v", got)
+	}
+
+	if got := NewAmount(10).Div(3); got != MustParseAmount("3.33") {
+		t.Errorf("Amount.Div() = %v", got)
+	}
+
+	if got := NewAmount(-10).Div(-4); got != MustParseAmount("2.50") {
+		t.Errorf("Amount.Div() = %v", got)
+	}
+
+	if got := NewAmount(-1).Div(8); got != MustParseAmount("-0.13") {
+		t.Errorf("Amount.Div() = %v", got)
+	}
+
+	// 0.7% of 12345
+	if got := NewAmount(12345).MulRatio(7, 1000); got != MustParseAmount("86.42") {
+		t.Errorf("Amount.MulRatio() = %v", got)
+	}
+
+	if a.Cmp(b) != 1 || b.Cmp(a) != -1 || a.Cmp(a) != 0 || !b.LessThan(a) || !a.GreaterThan(b) {
+		t.Errorf("Amount.Cmp() is invalid")
+	}
+
+	if got := a.Neg().Abs(); got != a {
+		t.Errorf("Amount.Abs() = %v", got)
+	}
+}
+
+func TestAmount_JSON(t *testing.T) {
+	var got struct {
+		String   Amount  `json:"string"`
+		Number   Amount  `json:"number"`
+		Float    Amount  `json:"float"`
+		Exponent Amount  `json:"exponent"`
+		Empty    Amount  `json:"empty"`
+		Null     *Amount `json:"null"`
+	}
+
+	err := json.Unmarshal([]byte(`{"string":"10000.00","number":12333,"float":4440.5,"exponent":1e+06,"empty":"","null":null}`), &got)
+	if err != nil {
+		t.Fatalf("json.Unmarshal() error = %v", err)
+	}
+
+	if got.String != NewAmount(10000) || got.Number != NewAmount(12333) || got.Float != MustParseAmount("4440.50") ||
+		got.Exponent != NewAmount(1000000) || !got.Empty.IsZero() || got.Null != nil {
+		t.Errorf("json.Unmarshal() = %+v", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"string":"abc"}`), &got); !errors.Is(err, ErrInvalidAmount) {
+		t.Errorf("json.Unmarshal() error = %v, want ErrInvalidAmount", err)
+	}
+
+	b, _ := json.Marshal(OrderItem{Price: MustParseAmount("10001.50")})
+	if want := `{"name":"","qty":0,"price":"10001.50","logo":""}`; string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+}
+
+func TestVirtualAccountPayload_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(VirtualAccountPatchPayload{
+		MinAmount: NewAmount(11000),
+		MaxAmount: NewAmount(13000),
+		Amount:    NewAmount(12000),
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	want := `{"expiry_minutes":0,"is_disabled":false,"va_ref_id":"","min_amount":11000,"max_amount":13000,"amount":12000}`
+	if string(b) != want {
+		t.Errorf("json.Marshal() = %s, want %s", b, want)
+	}
+
+	b, _ = json.Marshal(VirtualAccountPayload{Amount: NewAmount(12333), MinAmount: NewAmount(10000)})
+
+	var got map[string]any
+	json.Unmarshal(b, &got)
+	if got["amount"] != "12333" || got["min_amount"] != float64(10000) || got["max_amount"] != float64(0) {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
+
+func TestAmount_EncodeValues(t *testing.T) {
+	values, err := query.Values(PaymentMDRFeesOption{Amount: MustParseAmount("500000.50"), PaymentMethod: "all"})
+	if err != nil {
+		t.Fatalf("query.Values() error = %v", err)
+	}
+
+	if got := values.Encode(); got != "amount=500000.50&payment_method=all" {
+		t.Errorf("query.Values() = %v", got)
+	}
+}
@@ -0,0 +1,60 @@
+/*
+ * File Created: Monday, 19th October 2026 12:47:15 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import "strings"
+
+// Bank codes for BankCode of payloads, ex: VirtualAccountPayload, DisbursementItemPayload.
+const (
+	BankBCA     = "BCA"
+	BankBNI     = "BNI"
+	BankBRI     = "BRI"
+	BankMandiri = "MANDIRI"
+	BankPermata = "PERMATA"
+	BankCIMB    = "CIMB"
+	BankBSI     = "BSI"
+	BankDanamon = "DANAMON"
+	BankBTN     = "BTN"
+	BankMaybank = "MAYBANK"
+	BankOCBC    = "OCBC"
+)
+
+// Wallet codes for WalletType of payloads, ex: PaymentChargeEwalletPayload.
+const (
+	WalletOVO       = "OVO"
+	WalletDANA      = "DANA"
+	WalletShopeePay = "SHOPEEPAY"
+	WalletLinkAja   = "LINKAJA"
+	WalletGoPay     = "GOPAY"
+)
+
+// Retail store codes for BankCode of PaymentChargeRetailStorePayload.
+const (
+	RetailAlfamart  = "ALFAMART"
+	RetailIndomaret = "INDOMARET"
+)
+
+// BankCodes is every bank code above.
+var BankCodes = []string{BankBCA, BankBNI, BankBRI, BankMandiri, BankPermata, BankCIMB, BankBSI, BankDanamon, BankBTN, BankMaybank, BankOCBC}
+
+// VABankCodes is bank codes which support Virtual Account.
+var VABankCodes = []string{BankBCA, BankBNI, BankBRI, BankMandiri, BankPermata, BankCIMB, BankBSI}
+
+// WalletCodes is e-wallet codes supported by E-Wallet charge.
+var WalletCodes = []string{WalletOVO, WalletDANA, WalletShopeePay, WalletLinkAja, WalletGoPay}
+
+// SupportsVA reports whether bank code supports Virtual Account, code is case-insensitive.
+func SupportsVA(code string) bool {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	for _, c := range VABankCodes {
+		if c == code {
+			return true
+		}
+	}
+
+	return false
+}
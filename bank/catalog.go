@@ -0,0 +1,274 @@
+/*
+ * File Created: Monday, 19th October 2026 12:47:15 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package bank
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+)
+
+// DefaultTTL is how long banks from Fetch Bank List API are cached when Catalog.TTL is zero.
+const DefaultTTL = 24 * time.Hour
+
+// DefaultFetchTimeout is timeout of request to Fetch Bank List API when Catalog.FetchTimeout is zero.
+const DefaultFetchTimeout = 30 * time.Second
+
+// typeDisbursement is type of bank which supports disbursement.
+const typeDisbursement = "disbursement"
+
+// Catalog is list of banks from disbursement.Client.FetchBanks.
+// Banks are cached for TTL and concurrent calls while the cache is expired share one request.
+type Catalog struct {
+	Client *disbursement.Client
+	TTL    time.Duration
+	Now    func() time.Time // Used for testing, default time.Now
+
+	// FetchTimeout is timeout of the shared request to Fetch Bank List API, default DefaultFetchTimeout
+	FetchTimeout time.Duration
+
+	mu        sync.Mutex
+	banks     []disbursement.DisbursementBank
+	fetchedAt time.Time
+	inflight  *call
+}
+
+// call is in-flight request to Fetch Bank List API, shared by every caller waiting for it.
+type call struct {
+	done  chan struct{}
+	banks []disbursement.DisbursementBank
+	err   *durianpay.Error
+}
+
+// NewCatalog returns Catalog which caches banks for ttl, zero ttl uses DefaultTTL.
+func NewCatalog(client *disbursement.Client, ttl time.Duration) *Catalog {
+	return &Catalog{
+		Client: client,
+		TTL:    ttl,
+	}
+}
+
+// Banks returns every bank from cache, or from Fetch Bank List API when the cache is empty or expired.
+// The shared request keeps values of ctx of the caller which starts it but not its cancellation, so a caller which gives up
+// does not fail the others, it is bounded by FetchTimeout instead. Failed request is not cached. Returned slice must not be modified.
+func (c *Catalog) Banks(ctx context.Context) ([]disbursement.DisbursementBank, *durianpay.Error) {
+	c.mu.Lock()
+	if c.banks != nil && c.now().Sub(c.fetchedAt) < c.ttl() {
+		banks := c.banks
+		c.mu.Unlock()
+		return banks, nil
+	}
+
+	cl := c.inflight
+	if cl == nil {
+		cl = &call{done: make(chan struct{})}
+		c.inflight = cl
+		go c.fetch(ctx, cl)
+	}
+	c.mu.Unlock()
+
+	select {
+	case <-cl.done:
+		return cl.banks, cl.err
+	case <-ctx.Done():
+		return nil, durianpay.FromSDKError(ctx.Err())
+	}
+}
+
+// fetch requests banks for cl and stores them in cache when the request succeeds.
+func (c *Catalog) fetch(ctx context.Context, cl *call) {
+	ctx, cancel := context.WithTimeout(detachedContext{ctx}, c.fetchTimeout())
+	defer cancel()
+
+	banks, err := c.Client.FetchBanks(ctx)
+	if banks == nil && err == nil {
+		banks = []disbursement.DisbursementBank{}
+	}
+
+	c.mu.Lock()
+	cl.banks, cl.err = banks, err
+	if err == nil {
+		c.banks, c.fetchedAt = banks, c.now()
+	}
+	c.inflight = nil
+	c.mu.Unlock()
+
+	close(cl.done)
+}
+
+// Invalidate removes cached banks, so the next call requests Fetch Bank List API.
+func (c *Catalog) Invalidate() {
+	c.mu.Lock()
+	c.banks = nil
+	c.mu.Unlock()
+}
+
+// Lookup returns bank by code, code is case-insensitive.
+func (c *Catalog) Lookup(ctx context.Context, code string) (disbursement.DisbursementBank, bool, *durianpay.Error) {
+	banks, err := c.Banks(ctx)
+	if err != nil {
+		return disbursement.DisbursementBank{}, false, err
+	}
+
+	code = normalizeCode(code)
+	for _, b := range banks {
+		if normalizeCode(b.Code) == code {
+			return b, true, nil
+		}
+	}
+
+	return disbursement.DisbursementBank{}, false, nil
+}
+
+// LookupName returns bank by name, name is case-insensitive and prefix "Bank" is ignored, ex: "Bank Mandiri".
+func (c *Catalog) LookupName(ctx context.Context, name string) (disbursement.DisbursementBank, bool, *durianpay.Error) {
+	banks, err := c.Banks(ctx)
+	if err != nil {
+		return disbursement.DisbursementBank{}, false, err
+	}
+
+	name = normalizeName(name)
+	for _, b := range banks {
+		if normalizeName(b.Name) == name {
+			return b, true, nil
+		}
+	}
+
+	return disbursement.DisbursementBank{}, false, nil
+}
+
+// DisbursementCodes returns codes of banks which support disbursement.
+func (c *Catalog) DisbursementCodes(ctx context.Context) ([]string, *durianpay.Error) {
+	banks, err := c.Banks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := []string{}
+	for _, b := range banks {
+		if b.Type == typeDisbursement {
+			codes = append(codes, b.Code)
+		}
+	}
+
+	return codes, nil
+}
+
+// VACodes returns codes of banks which support Virtual Account, see durianpay.VABankCodes.
+func (c *Catalog) VACodes() []string {
+	return append([]string(nil), durianpay.VABankCodes...)
+}
+
+// Validate returns Error with ErrorCodeSDKValidation when code is not a disbursement bank of the catalog.
+func (c *Catalog) Validate(ctx context.Context, code string) *durianpay.Error {
+	return c.validateFields(ctx, []bankField{{path: "bank_code", code: code}})
+}
+
+// ValidateDisbursement checks BankCode of every item of payload before it is submitted.
+func (c *Catalog) ValidateDisbursement(ctx context.Context, payload durianpay.DisbursementPayload) *durianpay.Error {
+	fields := make([]bankField, 0, len(payload.Items))
+	for i, item := range payload.Items {
+		fields = append(fields, bankField{path: fmt.Sprintf("items[%d].bank_code", i), code: item.BankCode})
+	}
+
+	return c.validateFields(ctx, fields)
+}
+
+// ValidateVA returns Error with ErrorCodeSDKValidation when code does not support Virtual Account.
+func (c *Catalog) ValidateVA(code string) *durianpay.Error {
+	if durianpay.SupportsVA(code) {
+		return nil
+	}
+
+	return validationError([]durianpay.Errors{{
+		Field:   "bank_code",
+		Message: fmt.Sprintf("bank_code %s does not support virtual account", code),
+	}})
+}
+
+// bankField is bank code of payload at path.
+type bankField struct {
+	path string
+	code string
+}
+
+// validateFields checks every bank code of fields against disbursement banks.
+func (c *Catalog) validateFields(ctx context.Context, fields []bankField) *durianpay.Error {
+	codes, err := c.DisbursementCodes(ctx)
+	if err != nil {
+		return err
+	}
+
+	supported := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		supported[normalizeCode(code)] = true
+	}
+
+	errs := []durianpay.Errors{}
+	for _, f := range fields {
+		if !supported[normalizeCode(f.code)] {
+			errs = append(errs, durianpay.Errors{
+				Field:   f.path,
+				Message: fmt.Sprintf("bank_code %s is not supported for disbursement", f.code),
+			})
+		}
+	}
+
+	return validationError(errs)
+}
+
+func (c *Catalog) now() time.Time {
+	if c.Now != nil {
+		return c.Now()
+	}
+
+	return time.Now()
+}
+
+func (c *Catalog) ttl() time.Duration {
+	if c.TTL <= 0 {
+		return DefaultTTL
+	}
+
+	return c.TTL
+}
+
+func (c *Catalog) fetchTimeout() time.Duration {
+	if c.FetchTimeout <= 0 {
+		return DefaultFetchTimeout
+	}
+
+	return c.FetchTimeout
+}
+
+func validationError(errs []durianpay.Errors) *durianpay.Error {
+	return durianpay.FromValidationErrors("invalid bank code", errs)
+}
+
+// detachedContext is ctx without its deadline and cancellation, like context.WithoutCancel of Go 1.21.
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}       { return nil }
+func (detachedContext) Err() error                  { return nil }
+func (c detachedContext) Value(key any) any         { return c.parent.Value(key) }
+
+func normalizeCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func normalizeName(name string) string {
+	name = strings.ToUpper(strings.Join(strings.Fields(name), " "))
+	return strings.TrimPrefix(name, "BANK ")
+}
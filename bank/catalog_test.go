@@ -0,0 +1,239 @@
+/*
+ * File Created: Monday, 19th October 2026 12:47:15 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package bank
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	pathResponse    = "../internal/tests/response/"
+	pathFetchBanks  = "/v1/disbursements/banks"
+	fileFetchBanks  = pathResponse + "disbursement/fetch_bank_200.json"
+	fileInternal500 = pathResponse + "internal_server_error_500.json"
+)
+
+func newCatalog(t *testing.T, times int) *Catalog {
+	featureWrap := tests.FeatureWrap(t)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, durianpay.DurianpayURL+pathFetchBanks, nil, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			if err := json.Unmarshal(featureWrap.ResJSONByte(fileFetchBanks), response); err != nil {
+				panic(err)
+			}
+
+			return nil
+		}).
+		Times(times)
+
+	return NewCatalog(&disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, time.Hour)
+}
+
+func TestCatalog_Banks_Cache(t *testing.T) {
+	c := newCatalog(t, 2)
+
+	now := time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC)
+	c.Now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		banks, err := c.Banks(context.Background())
+		if err != nil || len(banks) != 2 {
+			t.Fatalf("Catalog.Banks() = %v, %v", banks, err)
+		}
+	}
+
+	// Expired cache requests the API again
+	now = now.Add(time.Hour)
+	if _, err := c.Banks(context.Background()); err != nil {
+		t.Fatalf("Catalog.Banks() error = %v", err)
+	}
+}
+
+func TestCatalog_Banks_Singleflight(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	release := make(chan struct{})
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, durianpay.DurianpayURL+pathFetchBanks, nil, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			<-release
+			if err := json.Unmarshal(featureWrap.ResJSONByte(fileFetchBanks), response); err != nil {
+				panic(err)
+			}
+
+			return nil
+		}).
+		Times(1)
+
+	c := NewCatalog(&disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if banks, err := c.Banks(context.Background()); err != nil || len(banks) != 2 {
+				t.Errorf("Catalog.Banks() = %v, %v", banks, err)
+			}
+		}()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+}
+
+func TestCatalog_Banks_ErrorNotCached(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	wantErr := durianpay.FromAPI(500, featureWrap.ResJSONByte(fileInternal500))
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, durianpay.DurianpayURL+pathFetchBanks, nil, nil, nil, gomock.Any()).
+		Return(wantErr).
+		Times(2)
+
+	c := NewCatalog(&disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, time.Hour)
+	for i := 0; i < 2; i++ {
+		if _, err := c.Banks(context.Background()); !reflect.DeepEqual(err, wantErr) {
+			t.Errorf("Catalog.Banks() error = %v, want %v", err, wantErr)
+		}
+	}
+}
+
+func TestCatalog_Lookup(t *testing.T) {
+	c := newCatalog(t, 1)
+	ctx := context.Background()
+
+	if bank, ok, err := c.Lookup(ctx, " mandiri"); err != nil || !ok || bank.ID != 2 {
+		t.Errorf("Catalog.Lookup() = %v, %v, %v", bank, ok, err)
+	}
+
+	if bank, ok, err := c.LookupName(ctx, "Bank  BCA"); err != nil || !ok || bank.Code != durianpay.BankBCA {
+		t.Errorf("Catalog.LookupName() = %v, %v, %v", bank, ok, err)
+	}
+
+	if _, ok, err := c.Lookup(ctx, "XYZ"); err != nil || ok {
+		t.Errorf("Catalog.Lookup() = %v, %v", ok, err)
+	}
+
+	codes, err := c.DisbursementCodes(ctx)
+	if want := []string{"BCA", "MANDIRI"}; err != nil || !reflect.DeepEqual(codes, want) {
+		t.Errorf("Catalog.DisbursementCodes() = %v, want %v", codes, want)
+	}
+}
+
+func TestCatalog_Validate(t *testing.T) {
+	c := newCatalog(t, 1)
+	ctx := context.Background()
+
+	if err := c.Validate(ctx, "bca"); err != nil {
+		t.Errorf("Catalog.Validate() = %v, want nil", err)
+	}
+
+	err := c.ValidateDisbursement(ctx, durianpay.DisbursementPayload{
+		Items: []durianpay.DisbursementItemPayload{{BankCode: "BCA"}, {BankCode: "BNI"}},
+	})
+	want := []durianpay.Errors{{Field: "items[1].bank_code", Message: "bank_code BNI is not supported for disbursement"}}
+	if err == nil || err.ErrorCode != durianpay.ErrorCodeSDKValidation || !reflect.DeepEqual(err.Errors, want) {
+		t.Errorf("Catalog.ValidateDisbursement() = %v, want %v", err, want)
+	}
+
+	if err := c.ValidateVA(durianpay.BankPermata); err != nil {
+		t.Errorf("Catalog.ValidateVA() = %v, want nil", err)
+	}
+
+	if err := c.ValidateVA(durianpay.BankOCBC); err == nil || err.ErrorCode != durianpay.ErrorCodeSDKValidation {
+		t.Errorf("Catalog.ValidateVA() = %v, want validation error", err)
+	}
+}
+
+func TestCatalog_Banks_FirstCallerCanceled(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	release := make(chan struct{})
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, durianpay.DurianpayURL+pathFetchBanks, nil, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			select {
+			case <-release:
+			case <-ctx.Done():
+				return durianpay.FromSDKError(ctx.Err())
+			}
+
+			if err := json.Unmarshal(featureWrap.ResJSONByte(fileFetchBanks), response); err != nil {
+				panic(err)
+			}
+
+			return nil
+		}).
+		Times(1)
+
+	c := NewCatalog(&disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, time.Hour)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	first := make(chan *durianpay.Error)
+	go func() {
+		_, err := c.Banks(ctx)
+		first <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	second := make(chan *durianpay.Error)
+	go func() {
+		_, err := c.Banks(context.Background())
+		second <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// The first caller gives up, the shared request keeps running for the second one
+	cancel()
+	if err := <-first; err == nil || !err.IsCanceled() {
+		t.Errorf("Catalog.Banks() first error = %v, want canceled", err)
+	}
+
+	close(release)
+	if err := <-second; err != nil {
+		t.Errorf("Catalog.Banks() second error = %v", err)
+	}
+}
+
+func TestCatalog_Banks_FetchTimeout(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, durianpay.DurianpayURL+pathFetchBanks, nil, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			<-ctx.Done()
+			return durianpay.FromSDKError(ctx.Err())
+		}).
+		Times(1)
+
+	c := NewCatalog(&disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, time.Hour)
+	c.FetchTimeout = 10 * time.Millisecond
+
+	if _, err := c.Banks(context.Background()); err == nil || !err.IsTimeout() {
+		t.Errorf("Catalog.Banks() error = %v, want timeout", err)
+	}
+}
@@ -7,6 +7,7 @@
 package client
 
 import (
+	durianpay "github.com/abmid/dpay-sdk-go"
 	"github.com/abmid/dpay-sdk-go/common"
 	"github.com/abmid/dpay-sdk-go/disbursement"
 	"github.com/abmid/dpay-sdk-go/ewalletaccount"
@@ -35,11 +36,15 @@ type Client struct {
 
 // Options represents of parameter option for NewClient.
 type Options struct {
-	ServerKey string
+	ServerKey      string
+	SkipValidation bool                  // Send payloads without client-side validation, see durianpay.Validate
+	PhoneFormat    durianpay.PhoneFormat // Convert phone numbers of payloads to this format, empty sends them as given, see durianpay.NormalizePhones
 }
 
 func (c *Client) Init() {
 	api := common.NewAPI(c.Opts.ServerKey)
+	api.SkipValidation = c.Opts.SkipValidation
+	api.PhoneFormat = c.Opts.PhoneFormat
 	c.Order = &order.Client{ServerKey: c.Opts.ServerKey, Api: api}
 	c.Payment = &payment.Client{ServerKey: c.Opts.ServerKey, Api: api}
 	c.Promo = &promo.Client{ServerKey: c.Opts.ServerKey, Api: api}
@@ -25,7 +25,9 @@ type Api interface {
 }
 
 type ApiImplement struct {
-	ServerKey string
+	ServerKey      string
+	SkipValidation bool                  // Skip checking body with durianpay.Validate before the request is sent
+	PhoneFormat    durianpay.PhoneFormat // Convert phone numbers of body to this format with durianpay.NormalizePhones before the request is sent, empty sends them as given
 }
 
 func NewAPI(serverKey string) *ApiImplement {
@@ -35,8 +37,25 @@ func NewAPI(serverKey string) *ApiImplement {
 }
 
 // Req is an http request made specifically to hit the DurianPay endpoint.
-// If the HTTP status code returned is not 2xx then an error will be returned
+// If the HTTP status code returned is not 2xx then an error will be returned.
+// Errors from the transport or decoding keep the original error, see durianpay.Error.Unwrap
+// The body is validated with durianpay.Validate unless SkipValidation is set, invalid body is not sent.
+// When PhoneFormat is set, phone numbers of the body are converted to PhoneFormat first.
 func (c *ApiImplement) Req(ctx context.Context, method string, url string, param any, body any, headers map[string]string, response any) *durianpay.Error {
+	if body != nil && c.PhoneFormat != "" {
+		normalized, err := durianpay.NormalizePhones(body, c.PhoneFormat)
+		if err != nil {
+			return err
+		}
+		body = normalized
+	}
+
+	if body != nil && !c.SkipValidation {
+		if err := durianpay.Validate(body); err != nil {
+			return err
+		}
+	}
+
 	parseBody, err := json.Marshal(body)
 	if err != nil {
 		return durianpay.FromSDKError(err)
@@ -44,6 +63,10 @@ func (c *ApiImplement) Req(ctx context.Context, method string, url string, param
 
 	base64SecretKey := base64.StdEncoding.EncodeToString([]byte(c.ServerKey + ":"))
 	httpReq, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(parseBody))
+	if err != nil {
+		return durianpay.FromSDKError(err)
+	}
+
 	httpReq.Header.Add("Content-Type", "application/json")
 	httpReq.Header.Add("Authorization", fmt.Sprintf("Basic %s", base64SecretKey))
 
@@ -82,7 +105,7 @@ func (c *ApiImplement) Req(ctx context.Context, method string, url string, param
 	if response != nil {
 		jsonErr := json.Unmarshal(resBody, response)
 		if jsonErr != nil {
-			return durianpay.FromSDKError(jsonErr)
+			return durianpay.FromDecodeError(httpRes.StatusCode, resBody, jsonErr)
 		}
 	}
 
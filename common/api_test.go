@@ -8,14 +8,20 @@ package common
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
 	"reflect"
 	"testing"
+	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 	"github.com/abmid/dpay-sdk-go/internal/tests"
 	"github.com/jarcoal/httpmock"
 )
 
+const htmlBadGateway = "<html><head><title>502 Bad Gateway</title></head><body>nginx</body></html>"
+
 func TestApiImplement_Req(t *testing.T) {
 	type param struct {
 		SkipValidation *bool  `url:"skip_validation"`
@@ -115,6 +121,22 @@ func TestApiImplement_Req(t *testing.T) {
 				ErrorCode:  "DPAY_INTERNAL_ERROR",
 			},
 		},
+		{
+			name: "Failed http response non-JSON body",
+			fields: fields{
+				ServerKey: "dpay_test_xxx",
+			},
+			args: args{
+				ctx:    context.Background(),
+				method: "GET",
+				url:    durianpay.DurianpayURL,
+			},
+			prepare: func(args args) {
+				httpmock.RegisterResponder(args.method, args.url, httpmock.NewStringResponder(502, htmlBadGateway))
+			},
+			wantRes:       nil,
+			wantDurianErr: durianpay.FromAPI(502, []byte(htmlBadGateway)),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -142,3 +164,138 @@ func TestApiImplement_Req(t *testing.T) {
 		})
 	}
 }
+
+func TestApiImplement_Req_ContextDeadline(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", durianpay.DurianpayURL, func(r *http.Request) (*http.Response, error) {
+		<-r.Context().Done()
+		return nil, r.Context().Err()
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	c := NewAPI("dpay_test_xxx")
+	gotDurianErr := c.Req(ctx, "GET", durianpay.DurianpayURL, nil, nil, nil, nil)
+	if gotDurianErr == nil {
+		t.Fatal("ApiImplement.Req() gotDurianErr = nil, want timeout error")
+	}
+
+	if !gotDurianErr.Is(context.DeadlineExceeded) || !errors.Is(gotDurianErr.Unwrap(), context.DeadlineExceeded) {
+		t.Errorf("ApiImplement.Req() gotDurianErr cause = %v, want %v", gotDurianErr.Unwrap(), context.DeadlineExceeded)
+	}
+
+	if gotDurianErr.ErrorCode != durianpay.ErrorCodeSDKTimeout {
+		t.Errorf("ApiImplement.Req() gotDurianErr.ErrorCode = %v, want %v", gotDurianErr.ErrorCode, durianpay.ErrorCodeSDKTimeout)
+	}
+}
+
+func TestApiImplement_Req_Validation(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("POST", durianpay.DurianpayURL,
+		tests.HttpMockResJSON(200, "../internal/tests/response/disbursement/validate_disbursement_200.json", nil))
+
+	payload := durianpay.DisbursementValidatePayload{AccountNumber: "1237-3738", BankCode: "bca"}
+
+	c := NewAPI("dpay_test_xxx")
+	gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil)
+	if gotDurianErr == nil || gotDurianErr.ErrorCode != durianpay.ErrorCodeSDKValidation {
+		t.Fatalf("ApiImplement.Req() gotDurianErr = %v, want validation error", gotDurianErr)
+	}
+
+	if httpmock.GetTotalCallCount() != 0 {
+		t.Errorf("ApiImplement.Req() sent invalid payload")
+	}
+
+	c.SkipValidation = true
+	if gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil); gotDurianErr != nil {
+		t.Errorf("ApiImplement.Req() gotDurianErr = %v, want nil", gotDurianErr)
+	}
+
+	if httpmock.GetTotalCallCount() != 1 {
+		t.Errorf("ApiImplement.Req() request count = %d, want 1", httpmock.GetTotalCallCount())
+	}
+}
+
+func TestApiImplement_Req_PhoneFormat(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var gotMobile string
+	httpmock.RegisterResponder("POST", durianpay.DurianpayURL, func(req *http.Request) (*http.Response, error) {
+		var body map[string]any
+		json.NewDecoder(req.Body).Decode(&body)
+		gotMobile, _ = body["mobile"].(string)
+		return httpmock.NewStringResponse(200, `{"data":{}}`), nil
+	})
+
+	payload := durianpay.EwalletAccountLinkPayload{Mobile: "+62 812-3456-789", WalletType: "GOPAY", RedirectURL: "https://example.com"}
+
+	c := NewAPI("dpay_test_xxx")
+	c.PhoneFormat = durianpay.PhoneFormatNational
+	if gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil); gotDurianErr != nil {
+		t.Fatalf("ApiImplement.Req() gotDurianErr = %v, want nil", gotDurianErr)
+	}
+
+	if gotMobile != "8123456789" {
+		t.Errorf("ApiImplement.Req() mobile = %v, want %v", gotMobile, "8123456789")
+	}
+
+	payload.Mobile = "021-555-1234"
+	gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil)
+	if gotDurianErr == nil || gotDurianErr.ErrorCode != durianpay.ErrorCodeSDKValidation {
+		t.Errorf("ApiImplement.Req() gotDurianErr = %v, want validation error", gotDurianErr)
+	}
+
+	if httpmock.GetTotalCallCount() != 1 {
+		t.Errorf("ApiImplement.Req() request count = %d, want 1", httpmock.GetTotalCallCount())
+	}
+}
+
+func TestApiImplement_Req_ValidateOrder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var gotMobile string
+	httpmock.RegisterResponder("POST", durianpay.DurianpayURL, func(req *http.Request) (*http.Response, error) {
+		var body durianpay.OrderPayload
+		json.NewDecoder(req.Body).Decode(&body)
+		gotMobile = body.Customer.Mobile
+		return httpmock.NewStringResponse(200, `{"data":{}}`), nil
+	})
+
+	payload := durianpay.OrderPayload{
+		Amount:     durianpay.NewAmount(10001),
+		Currency:   "IDR",
+		OrderRefID: "order_ref_001",
+		Customer:   durianpay.Customer{Email: "jane_doe@nomail.com", Mobile: "+62 857-2217-3217"},
+		Items:      []durianpay.OrderItem{{Name: "LED Television", Qty: 1, Price: durianpay.NewAmount(10000)}},
+	}
+
+	c := NewAPI("dpay_test_xxx")
+	c.PhoneFormat = durianpay.PhoneFormatNational
+
+	// Order checks of durianpay.ValidateOrder run with the tags, invalid order is not sent
+	gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil)
+	want := []durianpay.Errors{{Field: "amount", Message: "amount must be 10000, total of items, shipping_fee & fees"}}
+	if gotDurianErr == nil || !reflect.DeepEqual(gotDurianErr.Errors, want) {
+		t.Fatalf("ApiImplement.Req() gotDurianErr = %v, want %v", gotDurianErr, want)
+	}
+
+	payload.Amount = durianpay.NewAmount(10000)
+	if gotDurianErr := c.Req(context.Background(), "POST", durianpay.DurianpayURL, nil, payload, nil, nil); gotDurianErr != nil {
+		t.Fatalf("ApiImplement.Req() gotDurianErr = %v, want nil", gotDurianErr)
+	}
+
+	if gotMobile != "85722173217" {
+		t.Errorf("ApiImplement.Req() customer mobile = %v, want %v", gotMobile, "85722173217")
+	}
+
+	if httpmock.GetTotalCallCount() != 1 {
+		t.Errorf("ApiImplement.Req() request count = %d, want 1", httpmock.GetTotalCallCount())
+	}
+}
@@ -0,0 +1,231 @@
+/*
+ * File Created: Monday, 19th October 2026 1:28:30 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package common
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// DefaultBulkConcurrency is number of concurrent requests of BulkFetcher when Concurrency is zero.
+const DefaultBulkConcurrency = 4
+
+// BulkFetcher fetches every page of list API for bulk export. After the total is known from the first page,
+// the remaining pages are fetched concurrently and items are kept in order of the API.
+type BulkFetcher[T any] struct {
+	Fetch       PageFetcher[T]
+	Skip        int           // Offset of the first item
+	Limit       int           // Number of items per page, zero uses DefaultPageLimit
+	Concurrency int           // Maximum concurrent requests, zero uses DefaultBulkConcurrency
+	Interval    time.Duration // Minimum time between requests, zero means no rate limit
+}
+
+// BulkState is progress of BulkFetcher. When a request fails, pass the same state to Run again
+// to resume from the last successful page, pages fetched successfully are not requested again.
+type BulkState[T any] struct {
+	Items []T // Items in order from Skip up to Next
+	Total int // Total items reported by the first page
+	Next  int // Offset of the first page which is not in Items yet
+
+	started bool
+	done    bool
+	pages   map[int][]T // Fetched pages after Next, by skip
+}
+
+// Done reports whether every page has been fetched.
+func (s *BulkState[T]) Done() bool {
+	return s.done
+}
+
+// Run fetches pages into state until every page is fetched, the context is done or a request fails.
+// The first error is returned, state keeps every page fetched before it.
+func (b *BulkFetcher[T]) Run(ctx context.Context, state *BulkState[T]) *durianpay.Error {
+	if state.done {
+		return nil
+	}
+
+	limit := b.Limit
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+
+	wait, stop := b.limiter()
+	defer stop()
+
+	fetch := func(ctx context.Context, skip int) (Page[T], *durianpay.Error) {
+		if err := wait(ctx); err != nil {
+			return Page[T]{}, durianpay.FromSDKError(err)
+		}
+
+		page, err := b.Fetch(ctx, skip, limit)
+		page.Skip, page.Limit = skip, limit
+		return page, err
+	}
+
+	if !state.started {
+		page, err := fetch(ctx, b.Skip)
+		if err != nil {
+			return err
+		}
+
+		state.started, state.pages = true, map[int][]T{}
+		state.Items, state.Total, state.Next = page.Items, page.Total, b.Skip+limit
+
+		if page.Total == 0 {
+			state.done = !page.HasNext()
+		} else {
+			state.done = state.Next >= page.Total
+		}
+	}
+
+	// Without total the pages cannot be fetched concurrently
+	for state.Total == 0 && !state.done {
+		page, err := fetch(ctx, state.Next)
+		if err != nil {
+			return err
+		}
+
+		state.Items = append(state.Items, page.Items...)
+		state.Next += limit
+		state.done = !page.HasNext()
+	}
+
+	if state.done {
+		return nil
+	}
+
+	return b.runConcurrent(ctx, state, limit, fetch)
+}
+
+// runConcurrent fetches the remaining pages of known total with bounded concurrency.
+func (b *BulkFetcher[T]) runConcurrent(ctx context.Context, state *BulkState[T], limit int, fetch func(context.Context, int) (Page[T], *durianpay.Error)) *durianpay.Error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	skips := make(chan int)
+	go func() {
+		defer close(skips)
+		for skip := state.Next; skip < state.Total; skip += limit {
+			if _, ok := state.pages[skip]; ok {
+				continue
+			}
+
+			select {
+			case skips <- skip:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	concurrency := b.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultBulkConcurrency
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		firstErr *durianpay.Error
+		fetched  = map[int][]T{}
+	)
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for skip := range skips {
+				page, err := fetch(ctx, skip)
+
+				mu.Lock()
+				if err != nil {
+					// Requests canceled because of the first error are not reported
+					if firstErr == nil {
+						firstErr = err
+						cancel()
+					}
+				} else {
+					fetched[skip] = page.Items
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	for skip, items := range fetched {
+		state.pages[skip] = items
+	}
+
+	// Append pages in order until the first missing page, later pages stay for the next Run
+	for state.Next < state.Total {
+		items, ok := state.pages[state.Next]
+		if !ok {
+			break
+		}
+
+		delete(state.pages, state.Next)
+		state.Items = append(state.Items, items...)
+		state.Next += limit
+	}
+	state.done = state.Next >= state.Total
+
+	if firstErr != nil {
+		return firstErr
+	}
+
+	if !state.done {
+		if err := ctx.Err(); err != nil {
+			return durianpay.FromSDKError(err)
+		}
+	}
+
+	return nil
+}
+
+// limiter returns func which waits until the next request is allowed by Interval.
+func (b *BulkFetcher[T]) limiter() (wait func(context.Context) error, stop func()) {
+	if b.Interval <= 0 {
+		return func(ctx context.Context) error { return ctx.Err() }, func() {}
+	}
+
+	ticker := time.NewTicker(b.Interval)
+	tokens := make(chan struct{}, 1)
+	tokens <- struct{}{}
+
+	done := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				select {
+				case tokens <- struct{}{}:
+				default:
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	wait = func(ctx context.Context) error {
+		select {
+		case <-tokens:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return wait, func() {
+		ticker.Stop()
+		close(done)
+	}
+}
@@ -0,0 +1,292 @@
+/*
+ * File Created: Monday, 19th October 2026 1:28:30 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package common
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// bulkList is concurrency safe fake list API over items 0..n-1, fail returns error for the skip.
+type bulkList struct {
+	mu        sync.Mutex
+	n         int
+	withTotal bool
+	fail      map[int]bool
+	calls     []int
+	active    int
+	maxActive int
+}
+
+func (l *bulkList) fetch(ctx context.Context, skip, limit int) (Page[int], *durianpay.Error) {
+	l.mu.Lock()
+	l.calls = append(l.calls, skip)
+	l.active++
+	if l.active > l.maxActive {
+		l.maxActive = l.active
+	}
+	fail := l.fail[skip]
+	l.mu.Unlock()
+
+	// Later pages respond first, so ordering does not depend on the order of responses
+	time.Sleep(time.Duration(l.n-skip) * 50 * time.Microsecond)
+
+	l.mu.Lock()
+	l.active--
+	l.mu.Unlock()
+
+	if fail {
+		return Page[int]{}, &durianpay.Error{StatusCode: 500, ErrorCode: durianpay.ErrorCodeDPAYInternalError}
+	}
+
+	page := Page[int]{Items: []int{}}
+	for i := skip; i < skip+limit && i < l.n; i++ {
+		page.Items = append(page.Items, i)
+	}
+
+	if l.withTotal {
+		page.Total = l.n
+	}
+
+	return page, nil
+}
+
+func (l *bulkList) sortedCalls() []int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	calls := append([]int{}, l.calls...)
+	sort.Ints(calls)
+	return calls
+}
+
+func seq(from, to int) []int {
+	items := []int{}
+	for i := from; i < to; i++ {
+		items = append(items, i)
+	}
+
+	return items
+}
+
+func TestBulkFetcher_Run(t *testing.T) {
+	tests := []struct {
+		name          string
+		n             int
+		skip          int
+		limit         int
+		concurrency   int
+		withTotal     bool
+		wantItems     []int
+		wantCalls     []int
+		wantMaxActive int
+	}{
+		{
+			name:          "Concurrent",
+			n:             95,
+			limit:         10,
+			concurrency:   3,
+			withTotal:     true,
+			wantItems:     seq(0, 95),
+			wantCalls:     []int{0, 10, 20, 30, 40, 50, 60, 70, 80, 90},
+			wantMaxActive: 3,
+		},
+		{
+			name:        "Skip",
+			n:           25,
+			skip:        5,
+			limit:       10,
+			withTotal:   true,
+			wantItems:   seq(5, 25),
+			wantCalls:   []int{5, 15},
+			concurrency: 2,
+		},
+		{
+			name:      "Single page",
+			n:         3,
+			limit:     10,
+			withTotal: true,
+			wantItems: seq(0, 3),
+			wantCalls: []int{0},
+		},
+		{
+			name:      "Without total",
+			n:         25,
+			limit:     10,
+			wantItems: seq(0, 25),
+			wantCalls: []int{0, 10, 20},
+		},
+		{
+			name:      "Default limit",
+			n:         250,
+			withTotal: true,
+			wantItems: seq(0, 250),
+			wantCalls: []int{0, 100, 200},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := &bulkList{n: tt.n, withTotal: tt.withTotal}
+			b := &BulkFetcher[int]{Fetch: list.fetch, Skip: tt.skip, Limit: tt.limit, Concurrency: tt.concurrency}
+
+			state := &BulkState[int]{}
+			if err := b.Run(context.Background(), state); err != nil {
+				t.Fatalf("BulkFetcher.Run() error = %v", err)
+			}
+
+			if !state.Done() || !reflect.DeepEqual(state.Items, tt.wantItems) {
+				t.Errorf("BulkFetcher.Run() done = %v, items = %v, want %v", state.Done(), state.Items, tt.wantItems)
+			}
+
+			if got := list.sortedCalls(); !reflect.DeepEqual(got, tt.wantCalls) {
+				t.Errorf("BulkFetcher.Run() requests skip = %v, want %v", got, tt.wantCalls)
+			}
+
+			if tt.wantMaxActive > 0 && list.maxActive > tt.wantMaxActive {
+				t.Errorf("BulkFetcher.Run() concurrent requests = %d, want at most %d", list.maxActive, tt.wantMaxActive)
+			}
+		})
+	}
+}
+
+func TestBulkFetcher_Resume(t *testing.T) {
+	list := &bulkList{n: 50, withTotal: true, fail: map[int]bool{20: true}}
+	b := &BulkFetcher[int]{Fetch: list.fetch, Limit: 10, Concurrency: 1}
+
+	state := &BulkState[int]{}
+	err := b.Run(context.Background(), state)
+	if err == nil || err.StatusCode != 500 {
+		t.Fatalf("BulkFetcher.Run() error = %v, want 500", err)
+	}
+
+	if state.Done() || state.Next != 20 || state.Total != 50 || !reflect.DeepEqual(state.Items, seq(0, 20)) {
+		t.Fatalf("BulkFetcher.Run() state = %+v, want items until the failed page", state)
+	}
+
+	list.mu.Lock()
+	list.fail, list.calls = nil, nil
+	list.mu.Unlock()
+
+	if err := b.Run(context.Background(), state); err != nil {
+		t.Fatalf("BulkFetcher.Run() resume error = %v", err)
+	}
+
+	if !state.Done() || !reflect.DeepEqual(state.Items, seq(0, 50)) {
+		t.Errorf("BulkFetcher.Run() resume items = %v", state.Items)
+	}
+
+	if got := list.sortedCalls(); !reflect.DeepEqual(got, []int{20, 30, 40}) {
+		t.Errorf("BulkFetcher.Run() resume requests skip = %v, want [20 30 40]", got)
+	}
+
+	// Done state does not request again
+	if err := b.Run(context.Background(), state); err != nil || len(list.sortedCalls()) != 3 {
+		t.Errorf("BulkFetcher.Run() done state error = %v, requests = %v", err, list.sortedCalls())
+	}
+}
+
+func TestBulkFetcher_ResumeKeepsLaterPages(t *testing.T) {
+	list := &bulkList{n: 40, withTotal: true, fail: map[int]bool{10: true}}
+	b := &BulkFetcher[int]{Fetch: list.fetch, Limit: 10, Concurrency: 3}
+
+	state := &BulkState[int]{}
+	if err := b.Run(context.Background(), state); err == nil {
+		t.Fatal("BulkFetcher.Run() error = nil, want error")
+	}
+
+	if state.Next != 10 || !reflect.DeepEqual(state.Items, seq(0, 10)) {
+		t.Fatalf("BulkFetcher.Run() state next = %d, items = %v", state.Next, state.Items)
+	}
+
+	// Pages fetched after the failed page are not requested again
+	fetched := map[int]bool{}
+	for skip := range state.pages {
+		fetched[skip] = true
+	}
+
+	list.mu.Lock()
+	list.fail, list.calls = nil, nil
+	list.mu.Unlock()
+
+	if err := b.Run(context.Background(), state); err != nil {
+		t.Fatalf("BulkFetcher.Run() resume error = %v", err)
+	}
+
+	if !reflect.DeepEqual(state.Items, seq(0, 40)) {
+		t.Errorf("BulkFetcher.Run() resume items = %v", state.Items)
+	}
+
+	for _, skip := range list.sortedCalls() {
+		if fetched[skip] {
+			t.Errorf("BulkFetcher.Run() resume requests fetched page %d again", skip)
+		}
+	}
+}
+
+func TestBulkFetcher_FirstPageError(t *testing.T) {
+	list := &bulkList{n: 30, withTotal: true, fail: map[int]bool{0: true}}
+	b := &BulkFetcher[int]{Fetch: list.fetch, Limit: 10}
+
+	state := &BulkState[int]{}
+	if err := b.Run(context.Background(), state); err == nil {
+		t.Fatal("BulkFetcher.Run() error = nil, want error")
+	}
+
+	list.mu.Lock()
+	list.fail = nil
+	list.mu.Unlock()
+
+	if err := b.Run(context.Background(), state); err != nil || !reflect.DeepEqual(state.Items, seq(0, 30)) {
+		t.Errorf("BulkFetcher.Run() resume error = %v, items = %v", err, state.Items)
+	}
+}
+
+func TestBulkFetcher_Interval(t *testing.T) {
+	list := &bulkList{n: 40, withTotal: true}
+	interval := 20 * time.Millisecond
+	b := &BulkFetcher[int]{Fetch: list.fetch, Limit: 10, Concurrency: 4, Interval: interval}
+
+	start := time.Now()
+	state := &BulkState[int]{}
+	if err := b.Run(context.Background(), state); err != nil {
+		t.Fatalf("BulkFetcher.Run() error = %v", err)
+	}
+
+	// 4 requests, the first is not delayed
+	if elapsed := time.Since(start); elapsed < 3*interval {
+		t.Errorf("BulkFetcher.Run() took %v, want at least %v", elapsed, 3*interval)
+	}
+
+	if !reflect.DeepEqual(state.Items, seq(0, 40)) {
+		t.Errorf("BulkFetcher.Run() items = %v", state.Items)
+	}
+}
+
+func TestBulkFetcher_Cancel(t *testing.T) {
+	list := &bulkList{n: 100, withTotal: true}
+	b := &BulkFetcher[int]{Fetch: list.fetch, Limit: 10, Interval: time.Hour}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	state := &BulkState[int]{}
+	err := b.Run(ctx, state)
+	if err == nil || err.ErrorCode != durianpay.ErrorCodeSDKCanceled {
+		t.Fatalf("BulkFetcher.Run() error = %v, want canceled", err)
+	}
+
+	if state.Done() || !reflect.DeepEqual(state.Items, seq(0, 10)) {
+		t.Errorf("BulkFetcher.Run() items = %v, want first page", state.Items)
+	}
+}
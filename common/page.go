@@ -0,0 +1,193 @@
+/*
+ * File Created: Monday, 19th October 2026 12:54:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package common
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// DefaultPageLimit is number of items requested per page when limit of the option is zero.
+const DefaultPageLimit = 100
+
+// Page is a page of list API with uniform total, ex: Count of orders or TotalData of refunds.
+type Page[T any] struct {
+	Items []T
+	Total int // Total items of every page, zero when the API does not return it
+	Skip  int // Offset of the first item
+	Limit int // Requested number of items
+}
+
+// HasNext reports whether there are items after the page.
+// Without Total, a full page means there may be more items.
+func (p Page[T]) HasNext() bool {
+	if len(p.Items) == 0 {
+		return false
+	}
+
+	if p.Total > 0 {
+		return p.Skip+len(p.Items) < p.Total
+	}
+
+	return len(p.Items) >= p.Limit
+}
+
+// PageFetcher returns page of limit items starting at skip.
+type PageFetcher[T any] func(ctx context.Context, skip, limit int) (Page[T], *durianpay.Error)
+
+// Iterator pages through list API transparently, ex:
+//
+//	it := c.Order.IterateOrders(ctx, durianpay.OrderFetchOption{Limit: 50})
+//	for it.Next() {
+//		fmt.Println(it.Item().ID)
+//	}
+//	if err := it.Err(); err != nil {
+//		// Handle error
+//	}
+type Iterator[T any] struct {
+	ctx     context.Context
+	fetch   PageFetcher[T]
+	windows []PageFetcher[T] // Fetchers of the next date windows, see NewRangeIterator
+
+	page    Page[T]
+	index   int
+	fetched bool
+	skip    int
+	limit   int
+	err     *durianpay.Error
+}
+
+// NewIterator returns Iterator which starts at skip and fetches limit items per page, zero limit uses DefaultPageLimit.
+func NewIterator[T any](ctx context.Context, skip, limit int, fetch PageFetcher[T]) *Iterator[T] {
+	if limit <= 0 {
+		limit = DefaultPageLimit
+	}
+
+	return &Iterator[T]{
+		ctx:   ctx,
+		fetch: fetch,
+		skip:  skip,
+		limit: limit,
+		index: -1,
+	}
+}
+
+// NewRangeIterator returns Iterator over every window of r (see durianpay.DateRange.Windows) in order,
+// fetch returns page fetcher of a window. Skip applies to the first window, limit is the same as NewIterator.
+func NewRangeIterator[T any](ctx context.Context, r durianpay.DateRange, skip, limit int, fetch func(window durianpay.DateRange) PageFetcher[T]) *Iterator[T] {
+	windows := r.Windows()
+	fetchers := make([]PageFetcher[T], 0, len(windows))
+	for _, window := range windows {
+		fetchers = append(fetchers, fetch(window))
+	}
+
+	it := NewIterator(ctx, skip, limit, fetchers[0])
+	it.windows = fetchers[1:]
+
+	return it
+}
+
+// Next advances to the next item and fetches the next page when needed.
+// It returns false when there are no more items, the context is done or a request fails, see Err.
+func (it *Iterator[T]) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if err := it.ctx.Err(); err != nil {
+		it.err = durianpay.FromSDKError(err)
+		return false
+	}
+
+	if it.fetched && it.index+1 < len(it.page.Items) {
+		it.index++
+		return true
+	}
+
+	// NextPage returns false for page without items, so Next always moves to its first item
+	return it.NextPage() && it.Next()
+}
+
+// NextPage fetches the next page, items of the page are iterated from the start by Next.
+// It returns false when there are no more pages, the context is done or a request fails, see Err.
+func (it *Iterator[T]) NextPage() bool {
+	for {
+		if it.err != nil {
+			return false
+		}
+
+		// The last page of a window moves to the first page of the next window
+		if it.fetched && !it.page.HasNext() {
+			if len(it.windows) == 0 {
+				return false
+			}
+			it.fetch, it.windows = it.windows[0], it.windows[1:]
+			it.skip, it.fetched = 0, false
+		}
+
+		if err := it.ctx.Err(); err != nil {
+			it.err = durianpay.FromSDKError(err)
+			return false
+		}
+
+		skip := it.skip
+		if it.fetched {
+			skip = it.page.Skip + len(it.page.Items)
+		}
+
+		page, err := it.fetch(it.ctx, skip, it.limit)
+		if err != nil {
+			it.err = err
+			return false
+		}
+
+		page.Skip, page.Limit = skip, it.limit
+		it.page, it.index, it.fetched = page, -1, true
+
+		// Empty window is skipped
+		if len(page.Items) > 0 || len(it.windows) == 0 {
+			return len(page.Items) > 0
+		}
+	}
+}
+
+// Item returns the current item.
+func (it *Iterator[T]) Item() T {
+	return it.page.Items[it.index]
+}
+
+// Page returns the current page.
+func (it *Iterator[T]) Page() Page[T] {
+	return it.page
+}
+
+// Err returns error which stops the iteration, nil when every item is iterated.
+func (it *Iterator[T]) Err() *durianpay.Error {
+	return it.err
+}
+
+// All iterates the remaining items and returns them.
+func (it *Iterator[T]) All() ([]T, *durianpay.Error) {
+	items := []T{}
+	for it.Next() {
+		items = append(items, it.Item())
+	}
+
+	return items, it.Err()
+}
+
+// PageUint16 converts skip & limit for options with uint16 fields, ex: durianpay.OrderFetchOption.
+func PageUint16(skip, limit int) (uint16, uint16, *durianpay.Error) {
+	if skip > math.MaxUint16 || limit > math.MaxUint16 {
+		return 0, 0, durianpay.FromSDKError(fmt.Errorf("durianpay: skip %d or limit %d exceeds %d", skip, limit, math.MaxUint16))
+	}
+
+	return uint16(skip), uint16(limit), nil
+}
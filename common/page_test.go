@@ -0,0 +1,180 @@
+/*
+ * File Created: Monday, 19th October 2026 12:54:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package common
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// fakeList returns PageFetcher over items 0..n-1, withTotal controls whether the page has Total.
+func fakeList(n int, withTotal bool, calls *[]int) PageFetcher[int] {
+	return func(ctx context.Context, skip, limit int) (Page[int], *durianpay.Error) {
+		*calls = append(*calls, skip)
+
+		page := Page[int]{Items: []int{}}
+		for i := skip; i < skip+limit && i < n; i++ {
+			page.Items = append(page.Items, i)
+		}
+
+		if withTotal {
+			page.Total = n
+		}
+
+		return page, nil
+	}
+}
+
+func TestIterator(t *testing.T) {
+	tests := []struct {
+		name      string
+		n         int
+		skip      int
+		limit     int
+		withTotal bool
+		wantItems []int
+		wantCalls []int
+	}{
+		{name: "With total", n: 7, limit: 3, withTotal: true, wantItems: []int{0, 1, 2, 3, 4, 5, 6}, wantCalls: []int{0, 3, 6}},
+		{name: "Exact pages with total", n: 6, limit: 3, withTotal: true, wantItems: []int{0, 1, 2, 3, 4, 5}, wantCalls: []int{0, 3}},
+		{name: "Without total", n: 6, limit: 3, wantItems: []int{0, 1, 2, 3, 4, 5}, wantCalls: []int{0, 3, 6}},
+		{name: "Start from skip", n: 5, skip: 3, limit: 3, withTotal: true, wantItems: []int{3, 4}, wantCalls: []int{3}},
+		{name: "Empty", n: 0, limit: 3, withTotal: true, wantItems: []int{}, wantCalls: []int{0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := []int{}
+			got, err := NewIterator(context.Background(), tt.skip, tt.limit, fakeList(tt.n, tt.withTotal, &calls)).All()
+			if err != nil {
+				t.Fatalf("Iterator.All() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.wantItems) {
+				t.Errorf("Iterator.All() = %v, want %v", got, tt.wantItems)
+			}
+
+			if !reflect.DeepEqual(calls, tt.wantCalls) {
+				t.Errorf("Iterator.All() requests skip = %v, want %v", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestIterator_NextPage(t *testing.T) {
+	calls := []int{}
+	it := NewIterator(context.Background(), 0, 0, fakeList(250, true, &calls))
+
+	pages := []Page[int]{}
+	for it.NextPage() {
+		pages = append(pages, it.Page())
+	}
+
+	if len(pages) != 3 || pages[2].Skip != 200 || len(pages[2].Items) != 50 || pages[2].Total != 250 || pages[0].Limit != DefaultPageLimit {
+		t.Errorf("Iterator.NextPage() pages = %d, last = %+v", len(pages), pages[len(pages)-1].Skip)
+	}
+}
+
+func TestNewRangeIterator(t *testing.T) {
+	// 70 days are 3 windows of durianpay.MaxDateRangeDays days, the second window is empty
+	from := time.Date(2023, 7, 1, 0, 0, 0, 0, durianpay.Jakarta)
+	r := durianpay.NewDateRange(from, from.AddDate(0, 0, 69))
+	sizes := map[string]int{"2023-07-01..2023-07-31": 5, "2023-08-01..2023-08-31": 0, "2023-09-01..2023-09-08": 2}
+
+	type call struct {
+		window string
+		skip   int
+	}
+	calls := []call{}
+
+	it := NewRangeIterator(context.Background(), r, 1, 2, func(window durianpay.DateRange) PageFetcher[int] {
+		return func(ctx context.Context, skip, limit int) (Page[int], *durianpay.Error) {
+			calls = append(calls, call{window.String(), skip})
+
+			page := Page[int]{Items: []int{}, Total: sizes[window.String()]}
+			for i := skip; i < skip+limit && i < page.Total; i++ {
+				page.Items = append(page.Items, i)
+			}
+
+			return page, nil
+		}
+	})
+
+	got, err := it.All()
+	if err != nil {
+		t.Fatalf("Iterator.All() error = %v", err)
+	}
+
+	if want := []int{1, 2, 3, 4, 0, 1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Iterator.All() = %v, want %v", got, want)
+	}
+
+	wantCalls := []call{
+		{"2023-07-01..2023-07-31", 1},
+		{"2023-07-01..2023-07-31", 3},
+		{"2023-08-01..2023-08-31", 0},
+		{"2023-09-01..2023-09-08", 0},
+	}
+	if !reflect.DeepEqual(calls, wantCalls) {
+		t.Errorf("Iterator.All() requests = %v, want %v", calls, wantCalls)
+	}
+}
+
+func TestIterator_Cancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	calls := []int{}
+	it := NewIterator(ctx, 0, 2, fakeList(10, true, &calls))
+
+	for it.Next() {
+		if it.Item() == 2 {
+			cancel()
+		}
+	}
+
+	if err := it.Err(); err == nil || err.ErrorCode != durianpay.ErrorCodeSDKCanceled || !errors.Is(err.Unwrap(), context.Canceled) {
+		t.Errorf("Iterator.Err() = %v, want canceled", err)
+	}
+
+	if !reflect.DeepEqual(calls, []int{0, 2}) {
+		t.Errorf("Iterator requests skip = %v, want [0 2]", calls)
+	}
+}
+
+func TestIterator_Error(t *testing.T) {
+	wantErr := &durianpay.Error{StatusCode: 500, ErrorCode: durianpay.ErrorCodeDPAYInternalError}
+	it := NewIterator(context.Background(), 0, 2, func(ctx context.Context, skip, limit int) (Page[int], *durianpay.Error) {
+		if skip > 0 {
+			return Page[int]{}, wantErr
+		}
+		return Page[int]{Items: []int{1, 2}, Total: 4}, nil
+	})
+
+	got, err := it.All()
+	if err != wantErr || !reflect.DeepEqual(got, []int{1, 2}) {
+		t.Errorf("Iterator.All() = %v, %v, want [1 2], %v", got, err, wantErr)
+	}
+
+	if it.Next() {
+		t.Errorf("Iterator.Next() = true after error")
+	}
+}
+
+func TestPageUint16(t *testing.T) {
+	if skip, limit, err := PageUint16(65535, 100); err != nil || skip != 65535 || limit != 100 {
+		t.Errorf("PageUint16() = %v, %v, %v", skip, limit, err)
+	}
+
+	if _, _, err := PageUint16(65536, 100); err == nil {
+		t.Errorf("PageUint16() error = nil, want overflow error")
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * File Created: Monday, 19th October 2026 1:30:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+)
+
+const (
+	// DateFormat is format of from & to parameters of list APIs.
+	DateFormat = "2006-01-02"
+	// MaxDateRangeDays is the longest range of a window of DateRange.Windows. DurianPay API reference does not
+	// document a maximum range, 31 days is chosen by the SDK to keep a month of data in one window.
+	MaxDateRangeDays = 31
+)
+
+// Jakarta is Asia/Jakarta time zone, dates of DurianPay APIs are in this time zone.
+// Without time zone database it falls back to a fixed UTC+7, Indonesia has no daylight saving time.
+var Jakarta = loadJakarta()
+
+func loadJakarta() *time.Location {
+	if loc, err := time.LoadLocation("Asia/Jakarta"); err == nil {
+		return loc
+	}
+
+	return time.FixedZone("WIB", 7*60*60)
+}
+
+// DateRange is range of dates for From & To parameters of list APIs, both bounds are inclusive.
+// Bounds are dates in Jakarta, use NewDateRange or the helpers to create it, zero bound is not sent.
+//
+// Fetch methods send the range as is, Iterate methods fetch a range longer than MaxDateRangeDays window by window,
+// see Windows.
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// NewDateRange returns range from the date of from until the date of to, both in Jakarta.
+func NewDateRange(from, to time.Time) DateRange {
+	return DateRange{From: startOfDay(from), To: startOfDay(to)}
+}
+
+// Today returns range of today in Jakarta.
+func Today() DateRange {
+	return LastNDaysAt(time.Now(), 1)
+}
+
+// LastNDays returns range of the last n days in Jakarta, including today.
+func LastNDays(n int) DateRange {
+	return LastNDaysAt(time.Now(), n)
+}
+
+// LastNDaysAt returns range of the last n days until the date of now in Jakarta, including that date.
+func LastNDaysAt(now time.Time, n int) DateRange {
+	to := startOfDay(now)
+	if n < 1 {
+		n = 1
+	}
+
+	return DateRange{From: to.AddDate(0, 0, 1-n), To: to}
+}
+
+// Month returns range from the first until the last day of month.
+func Month(year int, month time.Month) DateRange {
+	from := time.Date(year, month, 1, 0, 0, 0, 0, Jakarta)
+	return DateRange{From: from, To: from.AddDate(0, 1, -1)}
+}
+
+// IsZero reports whether both bounds are zero, zero DateRange is omitted from parameters.
+func (r DateRange) IsZero() bool {
+	return r.From.IsZero() && r.To.IsZero()
+}
+
+// Days returns number of days in the range, zero when a bound is zero or From is after To.
+func (r DateRange) Days() int {
+	if r.From.IsZero() || r.To.IsZero() {
+		return 0
+	}
+
+	from, to := startOfDay(r.From), startOfDay(r.To)
+	if from.After(to) {
+		return 0
+	}
+
+	// Round handles days which are not 24 hours when Jakarta is loaded from the time zone database
+	return int(to.Sub(from).Round(24*time.Hour)/(24*time.Hour)) + 1
+}
+
+// Split returns consecutive ranges of at most days each covering r in order.
+// Range without both bounds or not longer than days is returned as is.
+func (r DateRange) Split(days int) []DateRange {
+	total := r.Days()
+	if days < 1 || total <= days {
+		return []DateRange{r}
+	}
+
+	windows := []DateRange{}
+	from := startOfDay(r.From)
+	for remaining := total; remaining > 0; remaining -= days {
+		n := days
+		if remaining < n {
+			n = remaining
+		}
+
+		windows = append(windows, DateRange{From: from, To: from.AddDate(0, 0, n-1)})
+		from = from.AddDate(0, 0, n)
+	}
+
+	return windows
+}
+
+// Windows splits r into ranges of at most MaxDateRangeDays days.
+func (r DateRange) Windows() []DateRange {
+	return r.Split(MaxDateRangeDays)
+}
+
+// String returns the range as from & to parameters, ex: 2023-09-01..2023-09-30.
+func (r DateRange) String() string {
+	return fmt.Sprintf("%s..%s", formatDate(r.From), formatDate(r.To))
+}
+
+// EncodeValues sets from & to parameters, it implements query.Encoder of go-querystring.
+// Name of the field is not used, list APIs always expect from & to.
+func (r DateRange) EncodeValues(_ string, v *url.Values) error {
+	if !r.From.IsZero() {
+		v.Set("from", formatDate(r.From))
+	}
+	if !r.To.IsZero() {
+		v.Set("to", formatDate(r.To))
+	}
+
+	return nil
+}
+
+func startOfDay(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	y, m, d := t.In(Jakarta).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, Jakarta)
+}
+
+func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.In(Jakarta).Format(DateFormat)
+}
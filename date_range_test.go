@@ -0,0 +1,132 @@
+/*
+ * File Created: Monday, 19th October 2026 1:30:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	goquery "github.com/google/go-querystring/query"
+)
+
+func jakartaDate(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, Jakarta)
+}
+
+func TestDateRange_Helpers(t *testing.T) {
+	// 2023-09-05 23:30 UTC is 2023-09-06 06:30 in Jakarta
+	now := time.Date(2023, 9, 5, 23, 30, 0, 0, time.UTC)
+	today := time.Now().In(Jakarta)
+
+	tests := []struct {
+		name string
+		got  DateRange
+		want DateRange
+	}{
+		{name: "Today", got: Today(), want: DateRange{From: jakartaDate(today.Year(), today.Month(), today.Day()), To: jakartaDate(today.Year(), today.Month(), today.Day())}},
+		{name: "LastNDaysAt", got: LastNDaysAt(now, 7), want: DateRange{From: jakartaDate(2023, 8, 31), To: jakartaDate(2023, 9, 6)}},
+		{name: "LastNDaysAt zero", got: LastNDaysAt(now, 0), want: DateRange{From: jakartaDate(2023, 9, 6), To: jakartaDate(2023, 9, 6)}},
+		{name: "Month", got: Month(2024, time.February), want: DateRange{From: jakartaDate(2024, 2, 1), To: jakartaDate(2024, 2, 29)}},
+		{name: "Month December", got: Month(2023, time.December), want: DateRange{From: jakartaDate(2023, 12, 1), To: jakartaDate(2023, 12, 31)}},
+		{
+			name: "NewDateRange",
+			got:  NewDateRange(time.Date(2023, 9, 1, 18, 0, 0, 0, time.UTC), time.Date(2023, 9, 3, 1, 0, 0, 0, time.UTC)),
+			want: DateRange{From: jakartaDate(2023, 9, 2), To: jakartaDate(2023, 9, 3)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !tt.got.From.Equal(tt.want.From) || !tt.got.To.Equal(tt.want.To) {
+				t.Errorf("%s = %v, want %v", tt.name, tt.got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRange_Days(t *testing.T) {
+	tests := []struct {
+		name string
+		r    DateRange
+		want int
+	}{
+		{name: "Month", r: Month(2023, time.September), want: 30},
+		{name: "Same day", r: DateRange{From: jakartaDate(2023, 9, 1), To: jakartaDate(2023, 9, 1)}, want: 1},
+		{name: "Reversed", r: DateRange{From: jakartaDate(2023, 9, 2), To: jakartaDate(2023, 9, 1)}, want: 0},
+		{name: "Open", r: DateRange{To: jakartaDate(2023, 9, 1)}, want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.r.Days(); got != tt.want {
+				t.Errorf("DateRange.Days() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDateRange_Split(t *testing.T) {
+	r := DateRange{From: jakartaDate(2023, 1, 1), To: jakartaDate(2023, 3, 15)}
+
+	want := []string{"2023-01-01..2023-01-31", "2023-02-01..2023-03-03", "2023-03-04..2023-03-15"}
+	got := []string{}
+	for _, w := range r.Windows() {
+		got = append(got, w.String())
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("DateRange.Windows() = %v, want %v", got, want)
+	}
+
+	if got := Month(2023, time.September).Split(31); len(got) != 1 || got[0] != Month(2023, time.September) {
+		t.Errorf("DateRange.Split() = %v, want the range as is", got)
+	}
+
+	if got := (DateRange{To: jakartaDate(2023, 9, 1)}).Split(7); len(got) != 1 {
+		t.Errorf("DateRange.Split() open range = %v, want the range as is", got)
+	}
+}
+
+func TestDateRange_EncodeValues(t *testing.T) {
+	tests := []struct {
+		name string
+		opt  any
+		want string
+	}{
+		{
+			name: "Order",
+			opt:  OrderFetchOption{Range: Month(2023, time.September), Limit: 10},
+			want: "from=2023-09-01&limit=10&skip=0&to=2023-09-30",
+		},
+		{
+			name: "Jakarta date",
+			opt:  PaymentFetchOption{Range: DateRange{From: time.Date(2023, 8, 31, 20, 0, 0, 0, time.UTC), To: time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)}},
+			want: "from=2023-09-01&limit=0&skip=0&to=2023-09-01",
+		},
+		{
+			name: "Only to",
+			opt:  SettlementOption{Range: DateRange{To: jakartaDate(2023, 9, 30)}},
+			want: "limit=0&skip=0&to=2023-09-30",
+		},
+		{
+			name: "Zero",
+			opt:  InvoiceFetchOption{Limit: 5},
+			want: "limit=5&skip=0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values, err := goquery.Values(tt.opt)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := values.Encode(); got != tt.want {
+				t.Errorf("query = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -13,41 +13,41 @@ Payloads
 // DisbursementValidatePayload is payload for request validate disbursement API
 type DisbursementValidatePayload struct {
 	XIdempotencyKey string `json:"-"`
-	AccountNumber   string `json:"account_number"`
-	BankCode        string `json:"bank_code"`
+	AccountNumber   string `json:"account_number" validate:"required,numeric"`
+	BankCode        string `json:"bank_code" validate:"required"`
 }
 
 // DisbursementPayload is payload for request disbursement API
 type DisbursementPayload struct {
 	XIdempotencyKey string                    `json:"-" validate:"required"`
 	IdempotencyKey  string                    `json:"-" validate:"required"`
-	Name            string                    `json:"name"`
+	Name            string                    `json:"name" validate:"required,max=255"`
 	Description     string                    `json:"description"`
-	Items           []DisbursementItemPayload `json:"items"`
+	Items           []DisbursementItemPayload `json:"items" validate:"required"`
 }
 
 // DisbursementItemPayload is part of DisbursementPayload for attribute items
 type DisbursementItemPayload struct {
 	AccountOwnerName string `json:"account_owner_name" validate:"required"`
 	BankCode         string `json:"bank_code" validate:"required"`
-	Amount           string `json:"amount" validate:"required"`
-	AccountNumber    string `json:"account_number" validate:"required"`
-	EmailRecipient   string `json:"email_recipient"`
-	PhoneNumber      string `json:"phone_number"`
+	Amount           Amount `json:"amount" validate:"required,amount"`
+	AccountNumber    string `json:"account_number" validate:"required,numeric"`
+	EmailRecipient   string `json:"email_recipient" validate:"email"`
+	PhoneNumber      string `json:"phone_number" phone:""`
 	Notes            string `json:"notes"`
 }
 
 // DisbursementApprovePayload is payload for request approve disbursement API
 type DisbursementApprovePayload struct {
 	XIdempotencyKey string `json:"-"`
-	ID              string `json:"id"` //Disbursement ID
+	ID              string `json:"id" validate:"required"` //Disbursement ID
 }
 
 // DisbursementTopupPayload is payload for request Topup Amount API
 type DisbursementTopupPayload struct {
 	XIdempotencyKey string `json:"-"`
-	BankID          uint16 `json:"bank_id"`
-	Amount          string `json:"amount"`
+	BankID          uint16 `json:"bank_id" validate:"required"`
+	Amount          Amount `json:"amount" validate:"required,amount"`
 }
 
 /*
@@ -67,6 +67,6 @@ type DisbursementApproveOption struct {
 
 // DisbursementFetchItemsOption is parameter for Fetch Disbursement Items API
 type DisbursementFetchItemsOption struct {
-	Skip  uint16 `json:"skip"`
-	Limit uint16 `json:"limit"`
+	Skip  uint16 `url:"skip"`
+	Limit uint16 `url:"limit"`
 }
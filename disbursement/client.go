@@ -113,6 +113,29 @@ func (c *Client) FetchItemsByID(ctx context.Context, ID string, opt *durianpay.D
 	return &res.Data, nil
 }
 
+// IterateItemsByID returns iterator over every item of disbursement ID, it fetches the next page when needed.
+// opt.Skip is the first offset and opt.Limit is number of items per page, nil opt or zero limit uses common.DefaultPageLimit.
+func (c *Client) IterateItemsByID(ctx context.Context, ID string, opt *durianpay.DisbursementFetchItemsOption) *common.Iterator[DisbursementBatchItem] {
+	pageOpt := durianpay.DisbursementFetchItemsOption{}
+	if opt != nil {
+		pageOpt = *opt
+	}
+
+	return common.NewIterator(ctx, int(pageOpt.Skip), int(pageOpt.Limit), func(ctx context.Context, skip, limit int) (common.Page[DisbursementBatchItem], *durianpay.Error) {
+		var err *durianpay.Error
+		if pageOpt.Skip, pageOpt.Limit, err = common.PageUint16(skip, limit); err != nil {
+			return common.Page[DisbursementBatchItem]{}, err
+		}
+
+		res, err := c.FetchItemsByID(ctx, ID, &pageOpt)
+		if err != nil {
+			return common.Page[DisbursementBatchItem]{}, err
+		}
+
+		return common.Page[DisbursementBatchItem]{Items: res.DisbursementBatchItems, Total: int(res.Count)}, nil
+	})
+}
+
 // FetchByID returns a response from Fetch Disbursement by ID API.
 //
 //	[Docs Fetch Disbursement]: https://durianpay.id/docs/api/disbursements/fetch-one/
@@ -188,10 +211,10 @@ func (c *Client) TopupAmount(ctx context.Context, payload durianpay.Disbursement
 // FetchBalance returns a response from Fetch Durianpay Balance API
 //
 //	[Docs Fetch Durianpay Balance]: https://durianpay.id/docs/api/disbursements/balance/
-func (c *Client) FetchBalance(ctx context.Context) (*int, *durianpay.Error) {
+func (c *Client) FetchBalance(ctx context.Context) (*durianpay.Amount, *durianpay.Error) {
 	tempRes := struct {
 		Data struct {
-			Balance int `json:"balance"`
+			Balance durianpay.Amount `json:"balance"`
 		} `json:"data"`
 	}{}
 
@@ -156,7 +156,7 @@ func TestClient_Submit(t *testing.T) {
 						{
 							AccountOwnerName: "Abdul Hamid",
 							BankCode:         "bca",
-							Amount:           "10000",
+							Amount:           durianpay.NewAmount(10000),
 							AccountNumber:    "8422647",
 							EmailRecipient:   "abdul.surel@gmail.com",
 							PhoneNumber:      "081234567890",
@@ -187,7 +187,7 @@ func TestClient_Submit(t *testing.T) {
 				ID:                 "dis_LjxhDKq8Am3427",
 				IdempotencyKey:     "0d5cb9a6-2488-4c86-1000-1502",
 				Name:               "test disb",
-				TotalAmount:        "20000",
+				TotalAmount:        durianpay.NewAmount(20000),
 				TotalDisbursements: 2,
 				Description:        "description",
 			},
@@ -334,7 +334,7 @@ func TestClient_Approve(t *testing.T) {
 				Name:               "sample disbursement",
 				Type:               "batch",
 				Status:             "approved",
-				TotalAmount:        "10000.00",
+				TotalAmount:        durianpay.MustParseAmount("10000.00"),
 				TotalDisbursements: 1,
 				Description:        "this is a sample disbursement",
 			},
@@ -453,7 +453,7 @@ func TestClient_FetchItemsByID(t *testing.T) {
 						AccountOwnerName:    "John Doe",
 						RealName:            "Dummy Name",
 						BankCode:            "bca",
-						Amount:              "10000",
+						Amount:              durianpay.NewAmount(10000),
 						AccountNumber:       "8422647",
 						EmailRecipient:      "john@nomail.com",
 						PhoneNumber:         "85609873209",
@@ -515,6 +515,34 @@ func TestClient_FetchItemsByID(t *testing.T) {
 	}
 }
 
+func TestClient_IterateItemsByID(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	url := strings.ReplaceAll(durianpay.DurianpayURL+pathFetchItemsByID, ":id", "dis_XXXXXXX")
+	apiMock.EXPECT().
+		Req(gomock.Any(), http.MethodGet, url, &durianpay.DisbursementFetchItemsOption{Limit: 100}, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			err := json.Unmarshal(featureWrap.ResJSONByte(pathResponseDisbursement+"fetch_disbursement_items_200.json"), response)
+			if err != nil {
+				panic(err)
+			}
+
+			return nil
+		})
+
+	got, gotErr := c.IterateItemsByID(context.Background(), "dis_XXXXXXX", nil).All()
+	if gotErr != nil || len(got) == 0 {
+		t.Errorf("Client.IterateItemsByID() = %v, %v", got, gotErr)
+	}
+}
+
 func TestClient_FetchByID(t *testing.T) {
 	featureWrap := tests.FeatureWrap(t)
 	defer featureWrap.Ctrl.Finish()
@@ -556,10 +584,10 @@ func TestClient_FetchByID(t *testing.T) {
 				Name:               "sample disbursement",
 				Type:               "batch",
 				Status:             "approved",
-				TotalAmount:        "10000.00",
+				TotalAmount:        durianpay.MustParseAmount("10000.00"),
 				TotalDisbursements: 1,
 				Description:        "this is a sample description",
-				Fees:               4000,
+				Fees:               durianpay.NewAmount(4000),
 				CreatedAt:          tests.StringToTime("2021-05-03T12:57:07.296575Z"),
 			},
 			wantErr: nil,
@@ -804,7 +832,7 @@ func TestClient_TopupAmount(t *testing.T) {
 			},
 			wantRes: &DisbursementTopup{
 				SenderBank:  "bni",
-				TotalAmount: "10000",
+				TotalAmount: durianpay.NewAmount(10000),
 				Status:      "processing",
 				ExpiryDate:  tests.StringToTime("2021-03-21T09:58:53Z"),
 				TransferTo: DisbursementTopupTransferTo{
@@ -864,7 +892,7 @@ func TestClient_FetchBalance(t *testing.T) {
 		name    string
 		args    args
 		prepare func(mock mocks, args args)
-		wantRes *int
+		wantRes *durianpay.Amount
 		wantErr *durianpay.Error
 	}{
 		{
@@ -884,7 +912,7 @@ func TestClient_FetchBalance(t *testing.T) {
 						return nil
 					})
 			},
-			wantRes: tests.ToPtr(949859471313),
+			wantRes: tests.ToPtr(durianpay.NewAmount(949859471313)),
 		},
 	}
 	for _, tt := range tests {
@@ -6,7 +6,11 @@
  */
 package disbursement
 
-import "time"
+import (
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
 
 // DisbursementValidate is struct for response validate disbursement API
 type DisbursementValidate struct {
@@ -18,16 +22,16 @@ type DisbursementValidate struct {
 
 // Disbursement is response from disbursement API
 type Disbursement struct {
-	ID                 string    `json:"id"`
-	IdempotencyKey     string    `json:"idempotency_key"`
-	Name               string    `json:"name"`
-	Type               string    `json:"type"`
-	Status             string    `json:"status"`
-	TotalAmount        string    `json:"total_amount"`
-	TotalDisbursements uint16    `json:"total_disbursements"`
-	Description        string    `json:"description"`
-	Fees               uint32    `json:"fees"`
-	CreatedAt          time.Time `json:"created_at"`
+	ID                 string           `json:"id"`
+	IdempotencyKey     string           `json:"idempotency_key"`
+	Name               string           `json:"name"`
+	Type               string           `json:"type"`
+	Status             string           `json:"status"`
+	TotalAmount        durianpay.Amount `json:"total_amount"`
+	TotalDisbursements uint16           `json:"total_disbursements"`
+	Description        string           `json:"description"`
+	Fees               durianpay.Amount `json:"fees"`
+	CreatedAt          time.Time        `json:"created_at"`
 }
 
 // DisbursementItem is response from fetch disbursement items API
@@ -44,7 +48,7 @@ type DisbursementBatchItem struct {
 	AccountOwnerName        string                              `json:"account_owner_name"`
 	RealName                string                              `json:"real_name"`
 	BankCode                string                              `json:"bank_code"`
-	Amount                  string                              `json:"amount"`
+	Amount                  durianpay.Amount                    `json:"amount"`
 	AccountNumber           string                              `json:"account_number"`
 	EmailRecipient          string                              `json:"email_recipient"`
 	PhoneNumber             string                              `json:"phone_number"`
@@ -60,7 +64,7 @@ type DisbursementBatchItem struct {
 	AllowRetrigger          bool                                `json:"allow_retrigger"`
 	SplitID                 string                              `json:"split_id"`
 	Receipt                 string                              `json:"receipt"`
-	Fee                     string                              `json:"fee"`
+	Fee                     durianpay.Amount                    `json:"fee"`
 	DisbursementStatusSetAt time.Time                           `json:"disbursement_status_set_at"`
 	FailureReson            string                              `json:"failure_reason"`
 }
@@ -84,7 +88,7 @@ type DisbursementBank struct {
 // DisbursementTopup is response from Topup Amount API
 type DisbursementTopup struct {
 	SenderBank  string                      `json:"sender_bank"`
-	TotalAmount string                      `json:"total_amount"`
+	TotalAmount durianpay.Amount            `json:"total_amount"`
 	Status      string                      `json:"status"`
 	ExpiryDate  time.Time                   `json:"expiry_date"`
 	TransferTo  DisbursementTopupTransferTo `json:"transfer_to"`
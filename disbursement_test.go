@@ -0,0 +1,26 @@
+/*
+ * File Created: Monday, 19th October 2026 12:30:08 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"testing"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Query of Fetch Disbursement Items API is encoded from url tags, json tags were ignored
+// and the parameters were sent as Skip & Limit which the API does not read.
+func TestDisbursementFetchItemsOption_EncodeValues(t *testing.T) {
+	values, err := query.Values(DisbursementFetchItemsOption{Skip: 10, Limit: 5})
+	if err != nil {
+		t.Fatalf("query.Values() error = %v", err)
+	}
+
+	if got := values.Encode(); got != "limit=5&skip=10" {
+		t.Errorf("query.Values() = %v, want limit=5&skip=10", got)
+	}
+}
@@ -13,15 +13,15 @@ const (
 type Customer struct {
 	CustomerRefID string          `json:"customer_ref_id"`
 	GivenName     string          `json:"given_name"`
-	Email         string          `json:"email"`
-	Mobile        string          `json:"mobile"`
+	Email         string          `json:"email" validate:"email"`
+	Mobile        string          `json:"mobile" phone:""`
 	Address       CustomerAddress `json:"address"`
 }
 
 // CustomerAddress is part of Customer for attribute Address
 type CustomerAddress struct {
 	ReceiverName  string `json:"receiver_name"`
-	ReceiverPhone string `json:"receiver_phone"`
+	ReceiverPhone string `json:"receiver_phone" phone:""`
 	Label         string `json:"label"`
 	AddressLine1  string `json:"address_line_1"`
 	AddressLine2  string `json:"address_line_2"`
@@ -6,15 +6,29 @@
  */
 package durianpay
 
-import "encoding/json"
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+)
 
 const (
 	ErrorCodeSDK                    = "SDK_ERROR"
+	ErrorCodeSDKTimeout             = "SDK_TIMEOUT"
+	ErrorCodeSDKCanceled            = "SDK_CANCELED"
+	ErrorCodeSDKConnection          = "SDK_CONNECTION_ERROR"
+	ErrorCodeSDKDecode              = "SDK_DECODE_ERROR"
+	ErrorCodeSDKValidation          = "SDK_VALIDATION_ERROR"
 	ErrorCodeDPAYInternalError      = "DPAY_INTERNAL_ERROR"
 	ErrorCodeDPAYUnauthorizedAccess = "DPAY_UNAUTHORIZED_ACCESS"
 	ErrorCodeDPAYInvalidRequest     = "DPAY_INVALID_REQUEST"
 )
 
+// maxRawBodyLength is maximum length of RawBody kept from undecodable response
+const maxRawBodyLength = 512
+
 // Error is commons response error DurianPay
 type Error struct {
 	StatusCode   int      // Response from http status code
@@ -23,6 +37,8 @@ type Error struct {
 	Errors       []Errors `json:"errors"`
 	Message      string   `json:"message"`
 	ResponseCode string   `json:"response_code"` // ResponseCode currenty only present for Invoice API
+	RawBody      string   `json:"-"`             // RawBody is truncated response body, only filled when the response cannot be decoded
+	cause        error
 }
 
 type Errors struct {
@@ -30,11 +46,92 @@ type Errors struct {
 	Message string `json:"message"`
 }
 
+// Unwrap returns the underlying error (ex: context.DeadlineExceeded, *net.OpError, *json.SyntaxError).
+// It returns nil for errors returned by DurianPay API.
+func (e *Error) Unwrap() error {
+	return e.cause
+}
+
+// Is reports whether the underlying error matches target, ex: err.Is(context.DeadlineExceeded).
+// Because Error has field Error it does not implement error interface,
+// use errors.Is(err.Unwrap(), target) when you need the standard library.
+func (e *Error) Is(target error) bool {
+	return errors.Is(e.cause, target)
+}
+
+// IsTimeout reports whether the request failed because of timeout or context deadline.
+func (e *Error) IsTimeout() bool {
+	return e.ErrorCode == ErrorCodeSDKTimeout
+}
+
+// IsCanceled reports whether the request failed because the context was canceled.
+func (e *Error) IsCanceled() bool {
+	return e.ErrorCode == ErrorCodeSDKCanceled
+}
+
+// Err returns e as error value for APIs which work with error interface, it returns nil if e is nil.
+// The returned error unwraps to the underlying cause, use AsError to get back *Error.
+func (e *Error) Err() error {
+	if e == nil {
+		return nil
+	}
+
+	return errorValue{e}
+}
+
+// AsError returns *Error from error returned by Error.Err.
+func AsError(err error) (*Error, bool) {
+	var v errorValue
+	if errors.As(err, &v) {
+		return v.e, true
+	}
+
+	return nil, false
+}
+
+// errorValue wraps Error to implement error interface
+type errorValue struct {
+	e *Error
+}
+
+func (v errorValue) Error() string {
+	message := v.e.Message
+	if message == "" {
+		message = v.e.Error
+	}
+
+	if v.e.StatusCode != 0 {
+		return fmt.Sprintf("durianpay: %s (status %d): %s", v.e.ErrorCode, v.e.StatusCode, message)
+	}
+
+	return fmt.Sprintf("durianpay: %s: %s", v.e.ErrorCode, message)
+}
+
+func (v errorValue) Unwrap() error {
+	return v.e.cause
+}
+
+// FromSDKError returns Error from error that happen inside the SDK.
+// The error is kept as cause and ErrorCode distinguishes timeout, cancellation, connection & decode failures.
 func FromSDKError(err error) *Error {
 	return &Error{
 		Error:     err.Error(),
-		ErrorCode: ErrorCodeSDK,
+		ErrorCode: sdkErrorCode(err),
 		Message:   err.Error(),
+		cause:     err,
+	}
+}
+
+// FromDecodeError returns Error for a response body that cannot be decoded.
+// StatusCode and truncated RawBody are preserved.
+func FromDecodeError(statusCode int, responseBody []byte, err error) *Error {
+	return &Error{
+		StatusCode: statusCode,
+		Error:      err.Error(),
+		ErrorCode:  ErrorCodeSDKDecode,
+		Message:    fmt.Sprintf("unable to decode response body with status code %d", statusCode),
+		RawBody:    truncateBody(responseBody),
+		cause:      err,
 	}
 }
 
@@ -43,8 +140,43 @@ func FromAPI(statusCode int, responseBody []byte) *Error {
 
 	err := json.Unmarshal(responseBody, &tempErr)
 	if err != nil {
-		return FromSDKError(err)
+		return FromDecodeError(statusCode, responseBody, err)
 	}
 
 	return &tempErr
 }
+
+// sdkErrorCode returns ErrorCode based on kind of err.
+func sdkErrorCode(err error) string {
+	var (
+		netErr         net.Error
+		opErr          *net.OpError
+		dnsErr         *net.DNSError
+		syntaxErr      *json.SyntaxError
+		unmarshalErr   *json.UnmarshalTypeError
+		invalidJSONErr *json.InvalidUnmarshalError
+	)
+
+	switch {
+	case errors.Is(err, context.Canceled):
+		return ErrorCodeSDKCanceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeSDKTimeout
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return ErrorCodeSDKTimeout
+	case errors.As(err, &dnsErr), errors.As(err, &opErr):
+		return ErrorCodeSDKConnection
+	case errors.As(err, &syntaxErr), errors.As(err, &unmarshalErr), errors.As(err, &invalidJSONErr):
+		return ErrorCodeSDKDecode
+	}
+
+	return ErrorCodeSDK
+}
+
+func truncateBody(body []byte) string {
+	if len(body) > maxRawBodyLength {
+		return string(body[:maxRawBodyLength]) + "..."
+	}
+
+	return string(body)
+}
@@ -0,0 +1,169 @@
+/*
+ * File Created: Monday, 19th October 2026 12:22:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// FieldError is an Errors entry resolved against the payload that was sent to DurianPay.
+type FieldError struct {
+	Field   string // Field as returned by DurianPay, ex: items[2].account_number
+	Path    string // Go struct field path, ex: Items[2].AccountNumber. Empty if the field cannot be resolved
+	Index   int    // Index of the item for batch payloads, -1 if the field is not part of a list
+	Message string
+}
+
+// FieldErrors is list of FieldError
+type FieldErrors []FieldError
+
+// ByItem returns field errors grouped by item index for batch payloads (ex: DisbursementPayload.Items).
+// Errors which are not part of any item are grouped on index -1.
+func (fe FieldErrors) ByItem() map[int]FieldErrors {
+	group := map[int]FieldErrors{}
+	for _, e := range fe {
+		group[e.Index] = append(group[e.Index], e)
+	}
+
+	return group
+}
+
+// FieldErrors returns Errors resolved against payload, see ResolveFieldErrors.
+func (e *Error) FieldErrors(payload any) FieldErrors {
+	return ResolveFieldErrors(payload, e.Errors)
+}
+
+// ResolveFieldErrors maps each Errors entry returned by DurianPay to the Go struct field of payload.
+// Field names are matched by json tag, nested fields & list items can be written as
+// `items[2].account_number` or `items.2.account_number`.
+func ResolveFieldErrors(payload any, errs []Errors) FieldErrors {
+	result := make(FieldErrors, 0, len(errs))
+	for _, e := range errs {
+		path, index := resolveFieldPath(reflect.TypeOf(payload), e.Field)
+		result = append(result, FieldError{
+			Field:   e.Field,
+			Path:    path,
+			Index:   index,
+			Message: e.Message,
+		})
+	}
+
+	return result
+}
+
+// resolveFieldPath walks t following field and returns the Go field path and the first list index found.
+func resolveFieldPath(t reflect.Type, field string) (string, int) {
+	index := -1
+	segments := splitFieldPath(field)
+	if t == nil || len(segments) == 0 {
+		return "", index
+	}
+
+	var path strings.Builder
+	for _, segment := range segments {
+		t = indirectType(t)
+
+		if i, err := strconv.Atoi(segment); err == nil {
+			if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+				return "", index
+			}
+
+			if index == -1 {
+				index = i
+			}
+
+			fmt.Fprintf(&path, "[%d]", i)
+			t = t.Elem()
+			continue
+		}
+
+		switch t.Kind() {
+		case reflect.Struct:
+			sf, ok := fieldByJSONName(t, segment)
+			if !ok {
+				return "", index
+			}
+
+			if path.Len() > 0 {
+				path.WriteString(".")
+			}
+			path.WriteString(sf.Name)
+			t = sf.Type
+		case reflect.Map:
+			fmt.Fprintf(&path, "[%q]", segment)
+			t = t.Elem()
+		default:
+			return "", index
+		}
+	}
+
+	return path.String(), index
+}
+
+// splitFieldPath splits DurianPay field into segments.
+// The field can contain a description after "=" (ex: amount='amount invalid') which is ignored.
+func splitFieldPath(field string) []string {
+	if i := strings.IndexAny(field, "= "); i >= 0 {
+		field = field[:i]
+	}
+
+	field = strings.ReplaceAll(field, "[", ".")
+	field = strings.ReplaceAll(field, "]", "")
+
+	segments := []string{}
+	for _, s := range strings.Split(field, ".") {
+		if s != "" {
+			segments = append(segments, s)
+		}
+	}
+
+	return segments
+}
+
+// fieldByJSONName returns struct field of t which has json name, including promoted fields from embedded struct.
+func fieldByJSONName(t reflect.Type, name string) (reflect.StructField, bool) {
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		tagName, _, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && tagName == "" && indirectType(sf.Type).Kind() == reflect.Struct {
+			if embedded, ok := fieldByJSONName(indirectType(sf.Type), name); ok {
+				return embedded, true
+			}
+			continue
+		}
+
+		if !sf.IsExported() {
+			continue
+		}
+
+		if tagName == "" {
+			tagName = sf.Name
+		}
+
+		if strings.EqualFold(tagName, name) {
+			return sf, true
+		}
+	}
+
+	return reflect.StructField{}, false
+}
+
+func indirectType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
@@ -0,0 +1,108 @@
+/*
+ * File Created: Monday, 19th October 2026 12:22:18 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestResolveFieldErrors(t *testing.T) {
+	type args struct {
+		payload any
+		errs    []Errors
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want FieldErrors
+	}{
+		{
+			name: "Batch payload with bracket index",
+			args: args{
+				payload: DisbursementPayload{},
+				errs: []Errors{
+					{Field: "name", Message: "name is required"},
+					{Field: "items[2].account_number", Message: "invalid account number"},
+					{Field: "items.0.bank_code", Message: "invalid bank code"},
+				},
+			},
+			want: FieldErrors{
+				{Field: "name", Path: "Name", Index: -1, Message: "name is required"},
+				{Field: "items[2].account_number", Path: "Items[2].AccountNumber", Index: 2, Message: "invalid account number"},
+				{Field: "items.0.bank_code", Path: "Items[0].BankCode", Index: 0, Message: "invalid bank code"},
+			},
+		},
+		{
+			name: "Nested struct, pointer payload & description after field",
+			args: args{
+				payload: &InvoiceCreatePayload{},
+				errs: []Errors{
+					{Field: "customer.address.postal_code", Message: "invalid postal code"},
+					{Field: "amount='amount invalid'", Message: "Please provide valid data"},
+					{Field: "partial_transaction_config.min_amount", Message: "invalid config"},
+				},
+			},
+			want: FieldErrors{
+				{Field: "customer.address.postal_code", Path: "Customer.Address.PostalCode", Index: -1, Message: "invalid postal code"},
+				{Field: "amount='amount invalid'", Path: "Amount", Index: -1, Message: "Please provide valid data"},
+				{Field: "partial_transaction_config.min_amount", Path: `PartialTransactionConfig["min_amount"]`, Index: -1, Message: "invalid config"},
+			},
+		},
+		{
+			name: "Unknown field",
+			args: args{
+				payload: DisbursementPayload{},
+				errs: []Errors{
+					{Field: "items[1].unknown", Message: "unknown"},
+					{Field: "", Message: "empty"},
+				},
+			},
+			want: FieldErrors{
+				{Field: "items[1].unknown", Path: "", Index: 1, Message: "unknown"},
+				{Field: "", Path: "", Index: -1, Message: "empty"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ResolveFieldErrors(tt.args.payload, tt.args.errs); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ResolveFieldErrors() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFieldErrors_ByItem(t *testing.T) {
+	err := &Error{
+		Errors: []Errors{
+			{Field: "description", Message: "too long"},
+			{Field: "items[0].amount", Message: "invalid amount"},
+			{Field: "items[1].bank_code", Message: "invalid bank code"},
+			{Field: "items[0].account_number", Message: "invalid account number"},
+		},
+	}
+
+	want := map[int]FieldErrors{
+		-1: {
+			{Field: "description", Path: "Description", Index: -1, Message: "too long"},
+		},
+		0: {
+			{Field: "items[0].amount", Path: "Items[0].Amount", Index: 0, Message: "invalid amount"},
+			{Field: "items[0].account_number", Path: "Items[0].AccountNumber", Index: 0, Message: "invalid account number"},
+		},
+		1: {
+			{Field: "items[1].bank_code", Path: "Items[1].BankCode", Index: 1, Message: "invalid bank code"},
+		},
+	}
+
+	if got := err.FieldErrors(DisbursementPayload{}).ByItem(); !reflect.DeepEqual(got, want) {
+		t.Errorf("FieldErrors.ByItem() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,114 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:02 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestFromSDKError(t *testing.T) {
+	tests := []struct {
+		name          string
+		err           error
+		wantErrorCode string
+	}{
+		{
+			name:          "Context canceled",
+			err:           fmt.Errorf("Get: %w", context.Canceled),
+			wantErrorCode: ErrorCodeSDKCanceled,
+		},
+		{
+			name:          "Context deadline exceeded",
+			err:           fmt.Errorf("Get: %w", context.DeadlineExceeded),
+			wantErrorCode: ErrorCodeSDKTimeout,
+		},
+		{
+			name:          "DNS failure",
+			err:           &net.DNSError{Err: "no such host", Name: "api.durianpay.id"},
+			wantErrorCode: ErrorCodeSDKConnection,
+		},
+		{
+			name:          "Connection refused",
+			err:           &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")},
+			wantErrorCode: ErrorCodeSDKConnection,
+		},
+		{
+			name:          "Decode failure",
+			err:           json.Unmarshal([]byte("<html>"), &struct{}{}),
+			wantErrorCode: ErrorCodeSDKDecode,
+		},
+		{
+			name:          "Other error",
+			err:           errors.New("something wrong"),
+			wantErrorCode: ErrorCodeSDK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FromSDKError(tt.err)
+			if got.ErrorCode != tt.wantErrorCode {
+				t.Errorf("FromSDKError() ErrorCode = %v, want %v", got.ErrorCode, tt.wantErrorCode)
+			}
+
+			if got.Unwrap() != tt.err || !got.Is(tt.err) {
+				t.Errorf("FromSDKError() Unwrap() = %v, want %v", got.Unwrap(), tt.err)
+			}
+		})
+	}
+}
+
+func TestFromAPI_NonJSON(t *testing.T) {
+	body := "<html><body>" + strings.Repeat("a", maxRawBodyLength) + "</body></html>"
+
+	got := FromAPI(502, []byte(body))
+	if got.StatusCode != 502 {
+		t.Errorf("FromAPI() StatusCode = %v, want %v", got.StatusCode, 502)
+	}
+
+	if got.ErrorCode != ErrorCodeSDKDecode {
+		t.Errorf("FromAPI() ErrorCode = %v, want %v", got.ErrorCode, ErrorCodeSDKDecode)
+	}
+
+	if want := body[:maxRawBodyLength] + "..."; got.RawBody != want {
+		t.Errorf("FromAPI() RawBody = %v, want %v", got.RawBody, want)
+	}
+
+	var syntaxErr *json.SyntaxError
+	if !errors.As(got.Unwrap(), &syntaxErr) {
+		t.Errorf("FromAPI() Unwrap() = %T, want *json.SyntaxError", got.Unwrap())
+	}
+}
+
+func TestError_Err(t *testing.T) {
+	var nilErr *Error
+	if nilErr.Err() != nil {
+		t.Errorf("Err() of nil = %v, want nil", nilErr.Err())
+	}
+
+	apiErr := FromAPI(500, []byte(`{"error":"internal error","error_code":"DPAY_INTERNAL_ERROR"}`))
+	err := fmt.Errorf("fetch payments: %w", apiErr.Err())
+
+	if want := "fetch payments: durianpay: DPAY_INTERNAL_ERROR (status 500): internal error"; err.Error() != want {
+		t.Errorf("Err().Error() = %v, want %v", err.Error(), want)
+	}
+
+	if got, ok := AsError(err); !ok || got != apiErr {
+		t.Errorf("AsError() = %v, %v, want %v", got, ok, apiErr)
+	}
+
+	sdkErr := FromSDKError(context.DeadlineExceeded)
+	if !errors.Is(sdkErr.Err(), context.DeadlineExceeded) {
+		t.Errorf("errors.Is(Err(), context.DeadlineExceeded) = false, want true")
+	}
+}
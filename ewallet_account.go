@@ -12,9 +12,9 @@ Payloads
 
 // EwalletAccountLinkPayload is payload for Link E-Wallet Account API.
 type EwalletAccountLinkPayload struct {
-	Mobile      string `json:"mobile"`
-	WalletType  string `json:"wallet_type"`
-	RedirectURL string `json:"redirect_url"`
+	Mobile      string `json:"mobile" validate:"required" phone:""`
+	WalletType  string `json:"wallet_type" validate:"required"`
+	RedirectURL string `json:"redirect_url" validate:"required,url"`
 }
 
 /*
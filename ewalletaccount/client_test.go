@@ -243,7 +243,7 @@ func TestClient_Detail(t *testing.T) {
 				RefID:      "7f125e70-095e-481d-8db8-241df9d5b86d",
 				Status:     "enabled",
 				Mobile:     "8888888888",
-				Balance:    "8000000.00",
+				Balance:    durianpay.MustParseAmount("8000000.00"),
 				Currency:   "IDR",
 				Token:      "53c385d4-e279-495f-835a-d5ed089fe2cb",
 			},
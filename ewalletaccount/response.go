@@ -6,6 +6,8 @@
  */
 package ewalletaccount
 
+import durianpay "github.com/abmid/dpay-sdk-go"
+
 // Link is struct for response Link E-Wallet Account API
 type Link struct {
 	WalletType     string `json:"wallet_type"`
@@ -27,11 +29,11 @@ type Unlink struct {
 
 // Detail is struct for response EWallet Account Details API
 type Detail struct {
-	WalletType string `json:"wallet_type"`
-	RefID      string `json:"ref_id"`
-	Status     string `json:"status"`
-	Mobile     string `json:"mobile"`
-	Balance    string `json:"balance"`
-	Currency   string `json:"currency"`
-	Token      string `json:"token"`
+	WalletType string           `json:"wallet_type"`
+	RefID      string           `json:"ref_id"`
+	Status     string           `json:"status"`
+	Mobile     string           `json:"mobile"`
+	Balance    durianpay.Amount `json:"balance"`
+	Currency   string           `json:"currency"`
+	Token      string           `json:"token"`
 }
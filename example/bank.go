@@ -0,0 +1,56 @@
+/*
+ * File Created: Monday, 19th October 2026 12:47:15 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package example
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/bank"
+	"github.com/abmid/dpay-sdk-go/client"
+)
+
+func BankCatalog() {
+	c := client.NewClient(client.Options{
+		ServerKey: "xxx-xxxx",
+	})
+
+	// Share one catalog, banks are fetched once every hour
+	catalog := bank.NewCatalog(c.Disbursement, time.Hour)
+
+	b, ok, err := catalog.LookupName(context.TODO(), "Bank Mandiri")
+	if err != nil {
+		// Handle error
+	}
+
+	if ok {
+		fmt.Println(b.Code)
+	}
+
+	payload := durianpay.DisbursementPayload{
+		XIdempotencyKey: "1",
+		IdempotencyKey:  "1",
+		Name:            "Salary",
+		Items: []durianpay.DisbursementItemPayload{
+			{
+				AccountOwnerName: "Goodman",
+				BankCode:         durianpay.BankBCA,
+				Amount:           durianpay.NewAmount(10000),
+				AccountNumber:    "8422647",
+			},
+		},
+	}
+
+	// Check bank codes before submission
+	if err := catalog.ValidateDisbursement(context.TODO(), payload); err != nil {
+		// Handle error, err.Errors contains the invalid items
+	}
+
+	fmt.Println(catalog.VACodes())
+}
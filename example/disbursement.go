@@ -49,7 +49,7 @@ func DisbursementSubmit() {
 			{
 				AccountOwnerName: "Jane Doe",
 				BankCode:         "bca",
-				Amount:           "10000",
+				Amount:           durianpay.NewAmount(10000),
 				AccountNumber:    "222444",
 				EmailRecipient:   "jane_doe@nomail.com",
 				PhoneNumber:      "081234567890",
@@ -0,0 +1,62 @@
+/*
+ * File Created: Monday, 19th October 2026 12:38:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package example
+
+import (
+	"fmt"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/fee"
+)
+
+func FeeCalculate() {
+	calculator := fee.NewCalculator(fee.Schedule{
+		fee.MethodOVO:  {Percentage: fee.MustPercent("1.5")},
+		fee.MethodQRIS: {Percentage: fee.MustPercent("0.7")},
+		fee.MethodBCA:  {Flat: durianpay.NewAmount(4000), Tax: fee.MustPercent("11")},
+	})
+
+	// Same response as c.Payment.MDRFeesCalculation without any request
+	res, calcErr := calculator.CalculateAll(durianpay.NewAmount(500000))
+	if calcErr != nil {
+		// Handle error
+	}
+	fmt.Println(res.OVO.Fees.Display(), res.BCA.ActualAmount.Display())
+
+	// Compare the schedule with MDR Fees Calculation API
+	mismatches, err := calculator.Validate(ctx, c.Payment, []durianpay.Amount{durianpay.NewAmount(10000), durianpay.NewAmount(500000)})
+	if err != nil {
+		// Handle error
+	}
+
+	for _, m := range mismatches {
+		fmt.Println(m.Method, m.Amount, m.Local.Fees, m.Remote.Fees)
+	}
+}
+
+func FeeGrossUp() {
+	calculator := fee.NewCalculator(fee.Schedule{
+		fee.MethodOVO:  {Percentage: fee.MustPercent("1.5")},
+		fee.MethodQRIS: {Percentage: fee.MustPercent("0.7")},
+	})
+
+	// Amount to charge customer, so the merchant receives Rp 10.000 after fee
+	quote, err := calculator.GrossUp(fee.MethodOVO, durianpay.NewAmount(10000))
+	if err != nil {
+		// Handle error
+	}
+
+	fmt.Println(quote.Gross.Display(), quote.Fee.Display())
+
+	// Comparison table across all payment methods
+	quotes, err := calculator.Compare(durianpay.NewAmount(10000))
+	if err != nil {
+		// Handle error
+	}
+
+	fmt.Print(quotes.Table())
+}
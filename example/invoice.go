@@ -15,8 +15,8 @@ import (
 
 func InvoiceCreate() {
 	payload := durianpay.InvoiceCreatePayload{
-		Amount:          "20000.67",
-		RemainingAmount: "5000.67",
+		Amount:          durianpay.MustParseAmount("20000.67"),
+		RemainingAmount: durianpay.MustParseAmount("5000.67"),
 		Title:           "sample",
 		InvoiceRefID:    "inv_ref_001",
 		Customer: durianpay.Customer{
@@ -55,8 +55,7 @@ func InvoiceCreate() {
 
 func InvoiceFetch() {
 	options := durianpay.InvoiceFetchOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
@@ -15,7 +15,7 @@ import (
 
 func OrderCreate() {
 	payload := durianpay.OrderPayload{
-		Amount:        "1000",
+		Amount:        durianpay.MustParseAmount("10001.00"),
 		PaymentOption: "full_payment",
 		Currency:      "IDR",
 		OrderRefID:    "order_ref_001",
@@ -41,7 +41,7 @@ func OrderCreate() {
 			{
 				Name:  "LED Television",
 				Qty:   1,
-				Price: "10001.00",
+				Price: durianpay.MustParseAmount("10001.00"),
 				Logo:  "https://merchant.com/tv_image.jpg",
 			},
 		},
@@ -62,8 +62,7 @@ func OrderCreate() {
 
 func OrderFetchOrders() {
 	options := durianpay.OrderFetchOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
@@ -74,3 +73,20 @@ func OrderFetchOrders() {
 
 	fmt.Println(res)
 }
+
+func OrderIterateOrders() {
+	options := durianpay.OrderFetchOption{
+		Range: durianpay.LastNDays(30),
+		Limit: 50,
+	}
+
+	// Pages are fetched when needed until every order is iterated
+	it := c.Order.IterateOrders(ctx, options)
+	for it.Next() {
+		fmt.Println(it.Item().ID, it.Page().Total)
+	}
+
+	if err := it.Err(); err != nil {
+		// Handle error
+	}
+}
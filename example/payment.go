@@ -7,10 +7,11 @@
 package example
 
 import (
+	"context"
 	"fmt"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/payment"
 )
 
 func PaymentCharge() {
@@ -19,7 +20,7 @@ func PaymentCharge() {
 		OrderID:      "ord_WkJWY1ysZ57194",
 		BankCode:     "MANDIRI",
 		Name:         "Name Appear in ATM",
-		Amount:       "20000",
+		Amount:       durianpay.NewAmount(20000),
 		PaymentRefID: "pay_ref_123",
 	}
 
@@ -31,10 +32,57 @@ func PaymentCharge() {
 	fmt.Println(res)
 }
 
+func PaymentChargeAnyMethod(req durianpay.ChargeRequest) {
+	// req is any payload, ex: durianpay.PaymentChargeEwalletPayload or durianpay.PaymentChargeQRISPayload
+	res, err := c.Payment.Charge(ctx, req)
+	if err != nil {
+		// Handle error
+	}
+
+	switch next := res.NextAction(); next.Type {
+	case payment.NextActionRedirect:
+		fmt.Println("redirect to", next.URL)
+	case payment.NextActionTransfer:
+		fmt.Println("pay to", next.AccountNumber, "before", res.ExpiresAt())
+	case payment.NextActionScanQR:
+		fmt.Println("scan", next.QRString)
+	}
+
+	// Response of the payment method
+	if va, ok := res.(*payment.ChargeVA); ok {
+		fmt.Println(va.Response.PaymentInstruction)
+	}
+}
+
+func PaymentWaitForPayment() {
+	res, err := c.Payment.ChargeQRIS(ctx, durianpay.PaymentChargeQRISPayload{
+		OrderID: "ord_QETgbs2UGL3100",
+		Type:    "DANA",
+		Amount:  durianpay.NewAmount(80001),
+	})
+	if err != nil {
+		// Handle error
+	}
+
+	// Stop waiting when the QR code expires
+	waitCtx, cancel := context.WithDeadline(ctx, res.Response.ExpirationTime)
+	defer cancel()
+
+	pay, err := c.Payment.WaitForPayment(waitCtx, res.Response.PaymentID, payment.WaitOption{
+		OnStatus: func(status payment.CheckPaymentStatus) {
+			fmt.Println("payment is", status.Status)
+		},
+	})
+	if err != nil {
+		// Handle error, err.IsTimeout() when the deadline is exceeded
+	}
+
+	fmt.Println(pay.Status)
+}
+
 func PaymentFetchPayments() {
 	options := durianpay.PaymentFetchOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
@@ -46,3 +94,16 @@ func PaymentFetchPayments() {
 
 	fmt.Println(res)
 }
+
+func PaymentVerifySignature() {
+	// Signature received from checkout success redirect or webhook payload.
+	// Verification is done locally, RemoteFallback will call Verify Payments API when local verification fails.
+	valid, err := c.Payment.VerifySignature(ctx, "ord_WkJWY1ysZ57194", "pay_pYQ319c4qo5956", "8450ccee77...", durianpay.PaymentVerifySignatureOption{
+		RemoteFallback: true,
+	})
+	if err != nil {
+		// Handle error
+	}
+
+	fmt.Println(valid)
+}
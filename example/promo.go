@@ -15,24 +15,28 @@ import (
 
 func PromoCreate() {
 	payload := durianpay.PromoPayload{
-		Type:     "card_promos",
+		Type:     durianpay.PromoTypeCard,
 		Label:    "SALE502022",
 		Currency: "IDR",
 		PromoDetails: durianpay.PromoDetails{
 			BinList:   []int{424242},
 			BankCodes: []string{},
 		},
-		DiscountType:       "percentage",
+		DiscountType:       durianpay.PromoDiscountPercentage,
 		Discount:           "10",
 		StartsAt:           time.Now(),
-		EndsAt:             time.Now(),
-		SubType:            "direct_discount",
-		LimitType:          "quota",
+		EndsAt:             time.Now().Add(72 * time.Hour),
+		SubType:            durianpay.PromoSubTypeDirectDiscount,
+		LimitType:          durianpay.PromoLimitQuota,
 		LimitValue:         "100",
-		PriceDeductionType: "total_price",
+		PriceDeductionType: durianpay.PromoDeductTotalPrice,
 		Code:               "SALE2022",
 	}
 
+	// Check the discount before the promo is created
+	simulation := durianpay.SimulatePromo(payload, durianpay.NewAmount(150000))
+	fmt.Println(simulation.Discount.Display(), simulation.Total.Display())
+
 	res, err := c.Promo.Create(ctx, payload)
 	if err != nil {
 		// Handle error
@@ -8,7 +8,6 @@ package example
 
 import (
 	"fmt"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 )
@@ -17,7 +16,7 @@ func RefundCreate() {
 	payload := durianpay.RefundPayload{
 		RefID:         "order_ref_241",
 		PaymentID:     "pay_y2yKEEWBYe1299",
-		Amount:        "10000",
+		Amount:        durianpay.NewAmount(10000),
 		UseRefundLink: false,
 		Notes:         "rejected product",
 	}
@@ -32,8 +31,7 @@ func RefundCreate() {
 
 func RefundFetch() {
 	options := durianpay.RefundFetchOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
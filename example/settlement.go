@@ -11,12 +11,13 @@ import (
 	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/common"
+	"github.com/abmid/dpay-sdk-go/settlement"
 )
 
 func SettlementFetch() {
 	options := durianpay.SettlementOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
@@ -28,3 +29,24 @@ func SettlementFetch() {
 
 	fmt.Println(res)
 }
+
+func SettlementBulkDetails() {
+	options := durianpay.SettlementOption{
+		Range: durianpay.LastNDays(30),
+		Limit: 100,
+	}
+
+	// Pages after the first are fetched by 4 requests at most, 10 requests per second
+	b := c.Settlement.BulkDetails(options)
+	b.Concurrency, b.Interval = 4, 100*time.Millisecond
+
+	state := &common.BulkState[settlement.SettlementDetail]{}
+	for retry := 0; retry < 3; retry++ {
+		// Run again with the same state to resume from the last successful page
+		if err := b.Run(ctx, state); err == nil {
+			break
+		}
+	}
+
+	fmt.Println(len(state.Items), state.Total, state.Done())
+}
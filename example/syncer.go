@@ -0,0 +1,39 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package example
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/syncer"
+)
+
+func SyncerStart(db *sql.DB) {
+	store := &syncer.SQLStore{DB: db, Placeholder: syncer.DollarPlaceholder}
+	if err := store.CreateTables(ctx); err != nil {
+		// Handle error
+	}
+
+	s := &syncer.Syncer{
+		Order:          c.Order,
+		Payment:        c.Payment,
+		Refund:         c.Refund,
+		VirtualAccount: c.VA,
+		Sink:           store,
+		Checkpoint:     store,
+		Overlap:        48 * time.Hour,
+	}
+
+	// Blocks until ctx is done
+	s.Start(ctx, 15*time.Minute, func(stats *syncer.Stats, err error) {
+		for kind, ks := range stats.Kinds {
+			fmt.Println(kind, ks.Fetched, ks.Inserted, ks.Updated, ks.Err)
+		}
+	})
+}
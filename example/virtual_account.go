@@ -8,7 +8,6 @@ package example
 
 import (
 	"fmt"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 )
@@ -18,7 +17,7 @@ func VirtualAccountCreate() {
 		BankCode: "PERMATA",
 		Name:     "Abdul Hamid",
 		IsClosed: true,
-		Amount:   "123000",
+		Amount:   durianpay.NewAmount(123000),
 		Customer: durianpay.VirtualAccountCustomer{
 			GivenName: "Abdul Hamid",
 			Mobile:    "+6285555555555",
@@ -26,10 +25,7 @@ func VirtualAccountCreate() {
 		},
 		ExpiryMinutes:           120,
 		AccountSuffix:           "123456",
-		IsReusable:              true,
 		VaRefID:                 "1234",
-		MinAmount:               10000,
-		MaxAmount:               20000,
 		AutoDisableAfterPayment: true,
 	}
 
@@ -41,10 +37,22 @@ func VirtualAccountCreate() {
 	fmt.Println(res)
 }
 
+func VirtualAccountCreateOpen() {
+	// Reusable open VA, customer can pay any amount between Rp 10.000 and Rp 5.000.000
+	payload := durianpay.NewOpenVirtualAccount(durianpay.BankBCA, "Abdul Hamid", durianpay.NewAmount(10000), durianpay.NewAmount(5000000))
+	payload.VaRefID = "1234"
+
+	res, err := c.VA.Create(ctx, payload)
+	if err != nil {
+		// Handle error, ex: err.Errors contains the violated rules
+	}
+
+	fmt.Println(res)
+}
+
 func VirtualAccountFetch() {
 	options := durianpay.VirtualAccountFetchOption{
-		From:  time.Now().Format("2006-01-02"),
-		To:    time.Now().Add(60 * time.Second).Format("2006-01-02"),
+		Range: durianpay.Today(),
 		Skip:  10,
 		Limit: 10,
 	}
@@ -0,0 +1,73 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package example
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/abmid/dpay-sdk-go/webhook"
+)
+
+func WebhookHandler() {
+	handler := webhook.NewHandler(func(ctx context.Context, event *webhook.Event) error {
+		switch data := event.Data.(type) {
+		case *webhook.PaymentEvent:
+			fmt.Println(event.Type, data.ID, data.Status)
+		case *webhook.DisbursementItemEvent:
+			fmt.Println(event.Type, data.ID, data.FailureReson)
+		}
+
+		// Returning error will respond 500, DurianPay will retry the webhook
+		return nil
+	})
+
+	// The response only has a generic message, log the error of the handler
+	handler.OnError = func(r *http.Request, event *webhook.Event, err error) {
+		log.Printf("webhook %s: %v", event.Type, err)
+	}
+
+	http.Handle("/durianpay/webhook", handler)
+}
+
+func WebhookDispatcher() {
+	dispatcher := webhook.NewDispatcher()
+	dispatcher.Use(webhook.Recover(log.Default()), webhook.Logging(log.Default()), webhook.RequirePaymentSignature("XXX-XXX"))
+
+	dispatcher.OnPaymentCompleted(func(ctx context.Context, event webhook.PaymentEvent) error {
+		fmt.Println(event.ID, event.OrderID, event.Amount)
+		return nil
+	})
+
+	dispatcher.OnDisbursementItemFailed(func(ctx context.Context, event webhook.DisbursementItemEvent) error {
+		// Returning error will respond 500, DurianPay will retry the webhook
+		return fmt.Errorf("unable to process %s", event.ID)
+	})
+
+	http.Handle("/durianpay/webhook", dispatcher.Handler())
+}
+
+func WebhookSimulator() {
+	simulator := webhook.NewSimulator("http://localhost:8080/durianpay/webhook", "XXX-XXX")
+
+	events, err := simulator.AllEvents()
+	if err != nil {
+		// Handle error
+	}
+
+	// Send every event type twice, like DurianPay retrying a delivered webhook
+	deliveries, err := simulator.Deliver(context.Background(), webhook.DeliverDuplicate, events...)
+	if err != nil {
+		// Handle error
+	}
+
+	for _, d := range deliveries {
+		fmt.Println(d.Event.Type, d.StatusCode, d.Err)
+	}
+}
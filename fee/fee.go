@@ -0,0 +1,270 @@
+/*
+ * File Created: Monday, 19th October 2026 12:38:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+
+// Package fee calculates MDR (Merchant Discount Rate) fees locally based on merchant's fee schedule,
+// so fees can be shown for every payment method without calling MDR Fees Calculation API.
+package fee
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"sort"
+	"strings"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/payment"
+)
+
+// Payment methods, the value is same as attribute in MDR Fees Calculation API response.
+const (
+	MethodGOPAY     = "GOPAY"
+	MethodDDCIMB    = "DD_CIMB"
+	MethodQRIS      = "QRIS"
+	MethodSHOPEEPAY = "SHOPEEPAY"
+	MethodOTHERS    = "OTHERS"
+	MethodINDOMARET = "INDOMARET"
+	MethodBRI       = "BRI"
+	MethodALFAMART  = "ALFAMART"
+	MethodJENIUSPAY = "JENIUSPAY"
+	MethodBCA       = "BCA"
+	MethodDDBRI     = "DD_BRI"
+	MethodCARD      = "CARD"
+	MethodBNI       = "BNI"
+	MethodOVO       = "OVO"
+	MethodDANA      = "DANA"
+	MethodMANDIRI   = "MANDIRI"
+	MethodPERMATA   = "PERMATA"
+	MethodLINKAJA   = "LINKAJA"
+	MethodDANAMON   = "DANAMON"
+	MethodCIMB      = "CIMB"
+	MethodSYARIAH   = "SYARIAH"
+)
+
+var (
+	ErrUnknownMethod     = errors.New("fee: payment method is not in schedule")
+	ErrUnsupportedMethod = errors.New("fee: payment method is not in MDR Fees Calculation response")
+	ErrInvalidRate       = errors.New("fee: invalid rate")
+)
+
+// rateScale is parts of Rate in 100%, Rate has precision up to 4 decimal places of percent.
+const rateScale = 1_000_000
+
+// Rate is decimal-safe percentage, ex: MustPercent("0.7") is 0.7%.
+type Rate struct {
+	ppm int64
+}
+
+// Percent parses percentage with up to 4 decimal places, ex: "1.5" or "0.675".
+func Percent(s string) (Rate, error) {
+	whole, fraction, hasFraction := strings.Cut(strings.TrimSuffix(strings.TrimSpace(s), "%"), ".")
+	if whole == "" || (hasFraction && fraction == "") || len(fraction) > 4 || !isDigits(whole) || !isDigits(fraction) {
+		return Rate{}, fmt.Errorf("%w: %q", ErrInvalidRate, s)
+	}
+
+	fraction += strings.Repeat("0", 4-len(fraction))
+
+	var ppm int64
+	for _, c := range whole + fraction {
+		ppm = ppm*10 + int64(c-'0')
+		if ppm > rateScale {
+			return Rate{}, fmt.Errorf("%w: %q is more than 100%%", ErrInvalidRate, s)
+		}
+	}
+
+	return Rate{ppm}, nil
+}
+
+// MustPercent is like Percent but panics if s cannot be parsed.
+func MustPercent(s string) Rate {
+	r, err := Percent(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return r
+}
+
+// IsZero reports whether rate is 0%.
+func (r Rate) IsZero() bool {
+	return r.ppm == 0
+}
+
+// Of returns r of amount, rounded to minor units.
+func (r Rate) Of(amount durianpay.Amount) durianpay.Amount {
+	return amount.MulRatio(r.ppm, rateScale)
+}
+
+// String returns rate as percentage, ex: "0.675%".
+func (r Rate) String() string {
+	s := fmt.Sprintf("%d.%04d", r.ppm/10000, r.ppm%10000)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+
+	return s + "%"
+}
+
+// Rounding is how fee is rounded to whole rupiah.
+type Rounding int
+
+const (
+	RoundHalfUp Rounding = iota // Round to nearest rupiah, half up. This is the default
+	RoundUp                     // Round up to next rupiah
+	RoundDown                   // Round down to previous rupiah
+)
+
+// Rule is fee schedule of a payment method.
+//
+// Fee is calculated as Percentage of amount plus Flat, limited by Min & Max, plus Tax of the fee,
+// then rounded to whole rupiah.
+type Rule struct {
+	Percentage Rate
+	Flat       durianpay.Amount
+	Min        durianpay.Amount // Minimum fee before tax, zero means no minimum
+	Max        durianpay.Amount // Maximum fee before tax, zero means no maximum
+	Tax        Rate             // Tax on fee, ex: VAT 11%
+	Rounding   Rounding
+}
+
+// Fee returns fee of amount based on rule.
+func (r Rule) Fee(amount durianpay.Amount) durianpay.Amount {
+	// Every value is scaled to minor units * rateScale * rateScale, so the fee is only rounded once
+	scale := big.NewInt(rateScale)
+
+	fee := new(big.Int).Mul(big.NewInt(amount.MinorUnits()), big.NewInt(r.Percentage.ppm))
+	fee.Add(fee, new(big.Int).Mul(big.NewInt(r.Flat.MinorUnits()), scale))
+
+	if !r.Min.IsZero() {
+		if minFee := new(big.Int).Mul(big.NewInt(r.Min.MinorUnits()), scale); fee.Cmp(minFee) < 0 {
+			fee = minFee
+		}
+	}
+
+	if !r.Max.IsZero() {
+		if maxFee := new(big.Int).Mul(big.NewInt(r.Max.MinorUnits()), scale); fee.Cmp(maxFee) > 0 {
+			fee = maxFee
+		}
+	}
+
+	fee.Mul(fee, big.NewInt(rateScale+r.Tax.ppm))
+
+	// Round to whole rupiah, 100 minor units
+	unit := new(big.Int).Mul(new(big.Int).Mul(scale, scale), big.NewInt(100))
+	rupiah, rem := new(big.Int).QuoRem(fee, unit, new(big.Int))
+
+	switch r.Rounding {
+	case RoundUp:
+		if rem.Sign() > 0 {
+			rupiah.Add(rupiah, big.NewInt(1))
+		}
+	case RoundDown:
+	default:
+		if rem.Mul(rem, big.NewInt(2)).Cmp(unit) >= 0 {
+			rupiah.Add(rupiah, big.NewInt(1))
+		}
+	}
+
+	return durianpay.NewAmount(rupiah.Int64())
+}
+
+// Schedule is fee schedule of merchant, key is payment method (ex: MethodOVO).
+type Schedule map[string]Rule
+
+// Calculator calculates MDR fees locally based on Schedule.
+type Calculator struct {
+	Schedule Schedule
+}
+
+// NewCalculator returns Calculator for schedule.
+func NewCalculator(schedule Schedule) *Calculator {
+	return &Calculator{
+		Schedule: schedule,
+	}
+}
+
+// Fee returns fee of amount for payment method.
+func (c *Calculator) Fee(method string, amount durianpay.Amount) (durianpay.Amount, error) {
+	rule, ok := c.Schedule[method]
+	if !ok {
+		return durianpay.Amount{}, fmt.Errorf("%w: %s", ErrUnknownMethod, method)
+	}
+
+	return rule.Fee(amount), nil
+}
+
+// Calculate returns MDR fee of amount for payment method, like MDR Fees Calculation API.
+// The fee is deducted from amount, so ActualAmount is amount received by merchant.
+func (c *Calculator) Calculate(method string, amount durianpay.Amount) (payment.MDRFee, error) {
+	fee, err := c.Fee(method, amount)
+	if err != nil {
+		return payment.MDRFee{}, err
+	}
+
+	return payment.MDRFee{
+		ActualAmount: amount.Sub(fee),
+		Fees:         fee,
+		TotalAmount:  amount,
+	}, nil
+}
+
+// CalculateAll returns MDR fees of amount for every payment method in schedule,
+// in the same shape as response of MDR Fees Calculation API.
+// Payment methods of schedule which have no field in payment.MDRFeesCalculation are returned in ErrUnsupportedMethod,
+// fees of the other methods are still set in the result.
+func (c *Calculator) CalculateAll(amount durianpay.Amount) (*payment.MDRFeesCalculation, error) {
+	res := &payment.MDRFeesCalculation{}
+
+	var unsupported []string
+	for method := range c.Schedule {
+		mdr, _ := c.Calculate(method, amount)
+		if !setMDRFee(res, method, mdr) {
+			unsupported = append(unsupported, method)
+		}
+	}
+
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return res, fmt.Errorf("%w: %s", ErrUnsupportedMethod, strings.Join(unsupported, ", "))
+	}
+
+	return res, nil
+}
+
+// mdrFees returns every MDRFee in res by payment method, including methods which are not returned by the API.
+func mdrFees(res *payment.MDRFeesCalculation) map[string]payment.MDRFee {
+	fees := map[string]payment.MDRFee{}
+
+	v := reflect.ValueOf(res).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		fees[v.Type().Field(i).Tag.Get("json")] = v.Field(i).Interface().(payment.MDRFee)
+	}
+
+	return fees
+}
+
+// setMDRFee sets MDRFee of payment method in res, it reports false if the method is unknown.
+func setMDRFee(res *payment.MDRFeesCalculation, method string, mdr payment.MDRFee) bool {
+	v := reflect.ValueOf(res).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if v.Type().Field(i).Tag.Get("json") == method {
+			v.Field(i).Set(reflect.ValueOf(mdr))
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,155 @@
+/*
+ * File Created: Monday, 19th October 2026 12:38:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package fee
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/payment"
+)
+
+func TestPercent(t *testing.T) {
+	tests := []struct {
+		s          string
+		wantString string
+		wantErr    bool
+	}{
+		{s: "1.5", wantString: "1.5%"},
+		{s: "0.675%", wantString: "0.675%"},
+		{s: "11", wantString: "11%"},
+		{s: "0", wantString: "0%"},
+		{s: "100", wantString: "100%"},
+		{s: "0.12345", wantErr: true},
+		{s: "100.01", wantErr: true},
+		{s: "1,5", wantErr: true},
+		{s: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := Percent(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Percent() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				if !errors.Is(err, ErrInvalidRate) {
+					t.Errorf("Percent() error = %v, want ErrInvalidRate", err)
+				}
+				return
+			}
+
+			if got.String() != tt.wantString {
+				t.Errorf("Percent() = %v, want %v", got, tt.wantString)
+			}
+		})
+	}
+}
+
+func TestRule_Fee(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   Rule
+		amount durianpay.Amount
+		want   durianpay.Amount
+	}{
+		{
+			name:   "Percentage",
+			rule:   Rule{Percentage: MustPercent("1.5")},
+			amount: durianpay.NewAmount(500000),
+			want:   durianpay.NewAmount(7500),
+		},
+		{
+			name:   "Percentage rounded half up",
+			rule:   Rule{Percentage: MustPercent("0.7")},
+			amount: durianpay.NewAmount(12345), // 86.415
+			want:   durianpay.NewAmount(86),
+		},
+		{
+			name:   "Percentage rounded up",
+			rule:   Rule{Percentage: MustPercent("0.7"), Rounding: RoundUp},
+			amount: durianpay.NewAmount(12345),
+			want:   durianpay.NewAmount(87),
+		},
+		{
+			name:   "Flat with tax",
+			rule:   Rule{Flat: durianpay.NewAmount(4000), Tax: MustPercent("11")},
+			amount: durianpay.NewAmount(100000),
+			want:   durianpay.NewAmount(4440),
+		},
+		{
+			name:   "Minimum fee",
+			rule:   Rule{Percentage: MustPercent("2.9"), Flat: durianpay.NewAmount(2000), Min: durianpay.NewAmount(5000)},
+			amount: durianpay.NewAmount(10000),
+			want:   durianpay.NewAmount(5000),
+		},
+		{
+			name:   "Maximum fee before tax",
+			rule:   Rule{Percentage: MustPercent("0.7"), Max: durianpay.NewAmount(10000), Tax: MustPercent("11")},
+			amount: durianpay.NewAmount(10000000),
+			want:   durianpay.NewAmount(11100),
+		},
+		{
+			name:   "Percentage with tax rounded once",
+			rule:   Rule{Percentage: MustPercent("0.7"), Tax: MustPercent("11"), Rounding: RoundDown},
+			amount: durianpay.NewAmount(12345), // 86.415 * 1.11 = 95.92065
+			want:   durianpay.NewAmount(95),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rule.Fee(tt.amount); got != tt.want {
+				t.Errorf("Rule.Fee() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculator_CalculateAll(t *testing.T) {
+	c := NewCalculator(Schedule{
+		MethodOVO:       {Percentage: MustPercent("1.5")},
+		MethodSHOPEEPAY: {Percentage: MustPercent("1.5")},
+		"UNKNOWN":       {Flat: durianpay.NewAmount(1000)},
+	})
+
+	// Same as response of MDR Fees Calculation API for amount 500000
+	want := &payment.MDRFeesCalculation{
+		OVO: payment.MDRFee{
+			ActualAmount: durianpay.NewAmount(492500),
+			Fees:         durianpay.NewAmount(7500),
+			TotalAmount:  durianpay.NewAmount(500000),
+		},
+		SHOPEEPAY: payment.MDRFee{
+			ActualAmount: durianpay.NewAmount(492500),
+			Fees:         durianpay.NewAmount(7500),
+			TotalAmount:  durianpay.NewAmount(500000),
+		},
+	}
+
+	got, err := c.CalculateAll(durianpay.NewAmount(500000))
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Calculator.CalculateAll() = %+v, want %+v", got, want)
+	}
+
+	if !errors.Is(err, ErrUnsupportedMethod) || !strings.Contains(err.Error(), "UNKNOWN") {
+		t.Errorf("Calculator.CalculateAll() error = %v, want ErrUnsupportedMethod for UNKNOWN", err)
+	}
+
+	delete(c.Schedule, "UNKNOWN")
+	if _, err := c.CalculateAll(durianpay.NewAmount(500000)); err != nil {
+		t.Errorf("Calculator.CalculateAll() error = %v, want nil", err)
+	}
+
+	if _, err := c.Calculate(MethodBCA, durianpay.NewAmount(10000)); !errors.Is(err, ErrUnknownMethod) {
+		t.Errorf("Calculator.Calculate() error = %v, want ErrUnknownMethod", err)
+	}
+}
@@ -0,0 +1,142 @@
+/*
+ * File Created: Monday, 19th October 2026 12:39:27 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package fee
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// ErrGrossUpImpossible is returned when fee of a rule always consumes the whole amount, ex: rate 100%.
+var ErrGrossUpImpossible = errors.New("fee: net amount cannot be reached")
+
+// maxGrossRupiah is upper limit of gross amount searched by GrossUp.
+const maxGrossRupiah = 1 << 50
+
+// Quote is gross amount to charge customer for a payment method, so the merchant receives Net after fee.
+type Quote struct {
+	Method   string
+	Net      durianpay.Amount // Requested net amount
+	Fee      durianpay.Amount // Fee of Gross
+	Gross    durianpay.Amount // Amount to charge customer, in whole rupiah
+	Received durianpay.Amount // Gross - Fee, it can be slightly more than Net because of rounding
+}
+
+// GrossUp returns the smallest gross amount in whole rupiah, so the merchant receives at least net after fee.
+// Rounding and Min & Max of the rule are taken into account.
+func (r Rule) GrossUp(net durianpay.Amount) (durianpay.Amount, error) {
+	// Amount charged in IDR is whole rupiah, so net is rounded up to whole rupiah
+	target := net.Rupiah()
+	if net.MinorUnits()%100 > 0 {
+		target++
+	}
+
+	if target <= 0 {
+		return durianpay.Amount{}, nil
+	}
+
+	received := func(gross int64) int64 {
+		amount := durianpay.NewAmount(gross)
+		return amount.Sub(r.Fee(amount)).MinorUnits()
+	}
+
+	want := durianpay.NewAmount(target).MinorUnits()
+
+	// Amount received grows with gross as long as the fee is less than the amount,
+	// so find upper bound then binary search the smallest gross
+	lo, hi := target, target
+	for received(hi) < want {
+		lo = hi + 1
+		hi *= 2
+		if hi > maxGrossRupiah {
+			return durianpay.Amount{}, fmt.Errorf("%w: %s", ErrGrossUpImpossible, net)
+		}
+	}
+
+	for lo < hi {
+		mid := lo + (hi-lo)/2
+		if received(mid) >= want {
+			hi = mid
+		} else {
+			lo = mid + 1
+		}
+	}
+
+	return durianpay.NewAmount(hi), nil
+}
+
+// GrossUp returns Quote of net for payment method, see Rule.GrossUp.
+func (c *Calculator) GrossUp(method string, net durianpay.Amount) (Quote, error) {
+	rule, ok := c.Schedule[method]
+	if !ok {
+		return Quote{}, fmt.Errorf("%w: %s", ErrUnknownMethod, method)
+	}
+
+	gross, err := rule.GrossUp(net)
+	if err != nil {
+		return Quote{}, fmt.Errorf("%s: %w", method, err)
+	}
+
+	fee := rule.Fee(gross)
+
+	return Quote{
+		Method:   method,
+		Net:      net,
+		Fee:      fee,
+		Gross:    gross,
+		Received: gross.Sub(fee),
+	}, nil
+}
+
+// Compare returns Quote of net for every payment method in schedule, sorted from the cheapest for customer.
+func (c *Calculator) Compare(net durianpay.Amount) (Quotes, error) {
+	quotes := make(Quotes, 0, len(c.Schedule))
+	for method := range c.Schedule {
+		quote, err := c.GrossUp(method, net)
+		if err != nil {
+			return nil, err
+		}
+
+		quotes = append(quotes, quote)
+	}
+
+	sort.Slice(quotes, func(i, j int) bool {
+		if quotes[i].Gross != quotes[j].Gross {
+			return quotes[i].Gross.LessThan(quotes[j].Gross)
+		}
+
+		return quotes[i].Method < quotes[j].Method
+	})
+
+	return quotes, nil
+}
+
+// Quotes is list of Quote, see Calculator.Compare.
+type Quotes []Quote
+
+// Table returns quotes as text table for display, ex:
+//
+//	METHOD  AMOUNT     FEE     TOTAL
+//	QRIS    Rp 10.000  Rp 70   Rp 10.070
+//	OVO     Rp 10.000  Rp 152  Rp 10.152
+func (q Quotes) Table() string {
+	var b strings.Builder
+
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "METHOD\tAMOUNT\tFEE\tTOTAL")
+	for _, quote := range q {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", quote.Method, quote.Net.Display(), quote.Fee.Display(), quote.Gross.Display())
+	}
+	w.Flush()
+
+	return b.String()
+}
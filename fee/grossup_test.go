@@ -0,0 +1,125 @@
+/*
+ * File Created: Monday, 19th October 2026 12:39:27 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package fee
+
+import (
+	"errors"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+func TestRule_GrossUp(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    Rule
+		net     durianpay.Amount
+		want    durianpay.Amount
+		wantErr error
+	}{
+		{
+			name: "Percentage",
+			rule: Rule{Percentage: MustPercent("1.5")},
+			net:  durianpay.NewAmount(10000),
+			want: durianpay.NewAmount(10152),
+		},
+		{
+			name: "Net with decimals is rounded up to rupiah",
+			rule: Rule{Percentage: MustPercent("0.7")},
+			net:  durianpay.MustParseAmount("9999.01"),
+			want: durianpay.NewAmount(10070),
+		},
+		{
+			name: "Flat with tax",
+			rule: Rule{Flat: durianpay.NewAmount(4000), Tax: MustPercent("11")},
+			net:  durianpay.NewAmount(100000),
+			want: durianpay.NewAmount(104440),
+		},
+		{
+			name: "Minimum fee",
+			rule: Rule{Percentage: MustPercent("2"), Min: durianpay.NewAmount(1000)},
+			net:  durianpay.NewAmount(10000),
+			want: durianpay.NewAmount(11000),
+		},
+		{
+			name: "Maximum fee",
+			rule: Rule{Percentage: MustPercent("0.7"), Max: durianpay.NewAmount(10000)},
+			net:  durianpay.NewAmount(10000000),
+			want: durianpay.NewAmount(10010000),
+		},
+		{
+			name: "Zero net",
+			rule: Rule{Flat: durianpay.NewAmount(4000)},
+			net:  durianpay.Amount{},
+			want: durianpay.Amount{},
+		},
+		{
+			name:    "Fee consumes the whole amount",
+			rule:    Rule{Percentage: MustPercent("100")},
+			net:     durianpay.NewAmount(10000),
+			wantErr: ErrGrossUpImpossible,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.rule.GrossUp(tt.net)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Rule.GrossUp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("Rule.GrossUp() = %v, want %v", got, tt.want)
+			}
+
+			if err != nil || tt.net.IsZero() {
+				return
+			}
+
+			// The merchant receives at least net, and one rupiah less would not be enough
+			if received := got.Sub(tt.rule.Fee(got)); received.LessThan(tt.net) {
+				t.Errorf("received %v is less than net %v", received, tt.net)
+			}
+
+			lower := got.Sub(durianpay.NewAmount(1))
+			if received := lower.Sub(tt.rule.Fee(lower)); !received.LessThan(tt.net) {
+				t.Errorf("gross %v is not the smallest", got)
+			}
+		})
+	}
+}
+
+func TestCalculator_Compare(t *testing.T) {
+	c := NewCalculator(Schedule{
+		MethodOVO:  {Percentage: MustPercent("1.5")},
+		MethodQRIS: {Percentage: MustPercent("0.7")},
+		MethodBCA:  {Flat: durianpay.NewAmount(4000), Tax: MustPercent("11")},
+	})
+
+	quotes, err := c.Compare(durianpay.NewAmount(10000))
+	if err != nil {
+		t.Fatalf("Calculator.Compare() error = %v", err)
+	}
+
+	want := "" +
+		"METHOD  AMOUNT     FEE       TOTAL\n" +
+		"QRIS    Rp 10.000  Rp 70     Rp 10.070\n" +
+		"OVO     Rp 10.000  Rp 152    Rp 10.152\n" +
+		"BCA     Rp 10.000  Rp 4.440  Rp 14.440\n"
+
+	if got := quotes.Table(); got != want {
+		t.Errorf("Quotes.Table() = \n%v, want \n%v", got, want)
+	}
+
+	if quotes[0].Received != durianpay.NewAmount(10000) {
+		t.Errorf("Quote.Received = %v", quotes[0].Received)
+	}
+
+	if _, err := c.GrossUp(MethodDANA, durianpay.NewAmount(10000)); !errors.Is(err, ErrUnknownMethod) {
+		t.Errorf("Calculator.GrossUp() error = %v, want ErrUnknownMethod", err)
+	}
+}
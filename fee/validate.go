@@ -0,0 +1,65 @@
+/*
+ * File Created: Monday, 19th October 2026 12:38:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package fee
+
+import (
+	"context"
+	"sort"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/payment"
+)
+
+// Mismatch is difference between local fee and MDR Fees Calculation API for a payment method & amount.
+type Mismatch struct {
+	Method string
+	Amount durianpay.Amount
+	Local  payment.MDRFee
+	Remote payment.MDRFee
+}
+
+// Validate compares local fees with MDR Fees Calculation API for every amount and returns the differences.
+// It sends one request per amount. Payment methods which are not returned by the API (not activated for the merchant)
+// are skipped, empty result means the schedule matches the API.
+func (c *Calculator) Validate(ctx context.Context, client *payment.Client, amounts []durianpay.Amount) ([]Mismatch, *durianpay.Error) {
+	methods := make([]string, 0, len(c.Schedule))
+	for method := range c.Schedule {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+
+	mismatches := []Mismatch{}
+	for _, amount := range amounts {
+		res, err := client.MDRFeesCalculation(ctx, durianpay.PaymentMDRFeesOption{
+			Amount:        amount,
+			PaymentMethod: "all",
+		})
+		if err != nil {
+			return mismatches, err
+		}
+
+		remote := mdrFees(res)
+		for _, method := range methods {
+			remoteFee, ok := remote[method]
+			if !ok || remoteFee == (payment.MDRFee{}) {
+				continue
+			}
+
+			localFee, _ := c.Calculate(method, amount)
+			if localFee != remoteFee {
+				mismatches = append(mismatches, Mismatch{
+					Method: method,
+					Amount: amount,
+					Local:  localFee,
+					Remote: remoteFee,
+				})
+			}
+		}
+	}
+
+	return mismatches, nil
+}
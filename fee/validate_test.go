@@ -0,0 +1,89 @@
+/*
+ * File Created: Monday, 19th October 2026 12:38:48 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package fee
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/golang/mock/gomock"
+)
+
+const pathResponsePayment = "../internal/tests/response/payment/"
+
+func TestCalculator_Validate(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	apiMock.EXPECT().
+		Req(gomock.Any(), "GET", gomock.Any(), durianpay.PaymentMDRFeesOption{Amount: durianpay.NewAmount(500000), PaymentMethod: "all"}, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			if err := json.Unmarshal(featureWrap.ResJSONByte(pathResponsePayment+"mdr_fees_calculation_200.json"), response); err != nil {
+				panic(err)
+			}
+
+			return nil
+		})
+
+	c := NewCalculator(Schedule{
+		MethodOVO:       {Percentage: MustPercent("1.5")},
+		MethodSHOPEEPAY: {Percentage: MustPercent("1.7")},
+		MethodBCA:       {Flat: durianpay.NewAmount(4000)}, // Not returned by the API
+	})
+
+	got, err := c.Validate(context.Background(), &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, []durianpay.Amount{durianpay.NewAmount(500000)})
+	if err != nil {
+		t.Fatalf("Calculator.Validate() error = %v", err)
+	}
+
+	want := []Mismatch{
+		{
+			Method: MethodSHOPEEPAY,
+			Amount: durianpay.NewAmount(500000),
+			Local: payment.MDRFee{
+				ActualAmount: durianpay.NewAmount(491500),
+				Fees:         durianpay.NewAmount(8500),
+				TotalAmount:  durianpay.NewAmount(500000),
+			},
+			Remote: payment.MDRFee{
+				ActualAmount: durianpay.NewAmount(492500),
+				Fees:         durianpay.NewAmount(7500),
+				TotalAmount:  durianpay.NewAmount(500000),
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Calculator.Validate() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalculator_Validate_Error(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	wantErr := durianpay.FromAPI(500, featureWrap.ResJSONByte("../internal/tests/response/internal_server_error_500.json"))
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	apiMock.EXPECT().
+		Req(gomock.Any(), "GET", gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+		Return(wantErr)
+
+	c := NewCalculator(Schedule{MethodOVO: {Percentage: MustPercent("1.5")}})
+
+	_, err := c.Validate(context.Background(), &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock}, []durianpay.Amount{durianpay.NewAmount(10000), durianpay.NewAmount(20000)})
+	if !reflect.DeepEqual(err, wantErr) {
+		t.Errorf("Calculator.Validate() error = %v, want %v", err, wantErr)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+ * File Created: Monday, 19th October 2026 4:12:37 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+
+// Package sqlfake is database/sql driver of tests, statements are handled by functions of the test.
+package sqlfake
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// DB handles statements with Exec & Query, transactions are accepted but not isolated.
+type DB struct {
+	mu sync.Mutex
+
+	// Statements are the executed queries in order
+	Statements []string
+	// Exec handles statement without rows, nil returns 1 affected row
+	Exec func(query string, args []driver.Value) (driver.Result, error)
+	// Query handles statement with rows of one column, nil returns no rows
+	Query func(query string, args []driver.Value) ([]driver.Value, error)
+}
+
+// Open returns *sql.DB of d which is closed when the test finishes.
+func Open(t *testing.T, d *DB) *sql.DB {
+	db := sql.OpenDB(d)
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+// Reset forgets the executed statements.
+func (d *DB) Reset() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Statements = nil
+}
+
+// Verbs returns the first word of each executed query, ex: SELECT, INSERT.
+func (d *DB) Verbs() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	verbs := []string{}
+	for _, query := range d.Statements {
+		verbs = append(verbs, strings.Fields(query)[0])
+	}
+
+	return verbs
+}
+
+func (d *DB) Connect(ctx context.Context) (driver.Conn, error) { return &conn{db: d}, nil }
+func (d *DB) Driver() driver.Driver                            { return d }
+func (d *DB) Open(name string) (driver.Conn, error)            { return &conn{db: d}, nil }
+
+type conn struct{ db *DB }
+
+func (c *conn) Prepare(query string) (driver.Stmt, error) {
+	return &stmt{db: c.db, query: query}, nil
+}
+func (c *conn) Close() error              { return nil }
+func (c *conn) Begin() (driver.Tx, error) { return tx{}, nil }
+
+type tx struct{}
+
+func (tx) Commit() error   { return nil }
+func (tx) Rollback() error { return nil }
+
+type stmt struct {
+	db    *DB
+	query string
+}
+
+func (s *stmt) Close() error  { return nil }
+func (s *stmt) NumInput() int { return -1 }
+
+func (s *stmt) Exec(args []driver.Value) (driver.Result, error) {
+	d := s.db
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Statements = append(d.Statements, s.query)
+	if d.Exec == nil {
+		return driver.RowsAffected(1), nil
+	}
+
+	return d.Exec(s.query, args)
+}
+
+func (s *stmt) Query(args []driver.Value) (driver.Rows, error) {
+	d := s.db
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.Statements = append(d.Statements, s.query)
+	if d.Query == nil {
+		return &rows{}, nil
+	}
+
+	values, err := d.Query(s.query, args)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rows{values: values}, nil
+}
+
+type rows struct{ values []driver.Value }
+
+func (r *rows) Columns() []string { return []string{"value"} }
+func (r *rows) Close() error      { return nil }
+
+func (r *rows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
@@ -14,10 +14,10 @@ Payloads
 
 // InvoiceCreate represents payload for Create Invoice API.
 type InvoiceCreatePayload struct {
-	Amount                   string         `json:"amount"`
-	RemainingAmount          string         `json:"remaining_amount"`
-	Title                    string         `json:"title"`
-	InvoiceRefID             string         `json:"invoice_ref_id"`
+	Amount                   Amount         `json:"amount" validate:"required,amount"`
+	RemainingAmount          Amount         `json:"remaining_amount" validate:"amount"`
+	Title                    string         `json:"title" validate:"required,max=255"`
+	InvoiceRefID             string         `json:"invoice_ref_id" validate:"required,max=255"`
 	Customer                 Customer       `json:"customer"`
 	EnablePartialTransaction bool           `json:"enable_partial_transaction"`
 	PartialTransactionConfig map[string]any `json:"partial_transaction_config"` // Key-Value pair that can be used to store configuration about partial transactions like minimum acceptable amount for a partial transaction
@@ -28,34 +28,34 @@ type InvoiceCreatePayload struct {
 // InvoiceUpdate represents payload for Update Invoice API.
 type InvoiceUpdatePayload struct {
 	InvoiceRefID             string         `json:"invoice_ref_id"`
-	Title                    string         `json:"title"`
+	Title                    string         `json:"title" validate:"max=255"`
 	StartDate                time.Time      `json:"start_date"`
 	DueDate                  time.Time      `json:"due_date"`
-	InvoiceURL               string         `json:"invoice_url"`
+	InvoiceURL               string         `json:"invoice_url" validate:"url"`
 	EnablePartialTransaction bool           `json:"enable_partial_transaction"`
 	PartialTransactionConfig map[string]any `json:"partial_transaction_config"` // Key-Value pair that can be used to store configuration about partial transactions like minimum acceptable amount for a partial transaction
 	IsBlocked                bool           `json:"is_blocked"`
-	RemainingAmount          string         `json:"remaining_amount"`
+	RemainingAmount          Amount         `json:"remaining_amount" validate:"amount"`
 	Metadata                 map[string]any `json:"metadata"`
 }
 
 // InvoicePay represents payload for Pay Invoice API.
 type InvoicePayPayload struct {
-	BankCode   string    `json:"bank_code"`
-	Invoices   []Invoice `json:"invoices"`
-	CustomerID string    `json:"customer_id"`
+	BankCode   string    `json:"bank_code" validate:"required"`
+	Invoices   []Invoice `json:"invoices" validate:"required"`
+	CustomerID string    `json:"customer_id" validate:"required"`
 }
 
 // Invoice is part of InvoicePay for attribute Invoices.
 type Invoice struct {
-	ID                string `json:"id"`
-	TransactionAmount string `json:"transaction_amount"`
+	ID                string `json:"id" validate:"required"`
+	TransactionAmount Amount `json:"transaction_amount" validate:"required,amount"`
 }
 
 // InvoiceManualPay represents payload for Manual Payment for Invoice API.
 type InvoiceManualPayPayload struct {
-	ID     string `json:"id"`
-	Amount string `json:"amount"`
+	ID     string `json:"id" validate:"required"`
+	Amount Amount `json:"amount" validate:"required,amount"`
 }
 
 /*
@@ -64,9 +64,8 @@ Options
 
 // InvoiceFetchOption represents paramater for List Invoices API.
 type InvoiceFetchOption struct {
-	From   string `json:"from"`
-	To     string `json:"to"`
-	Skip   uint32 `json:"skip"`
-	Limit  uint16 `json:"limit"`
-	Status string `json:"status"`
+	Range  DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip   uint32    `url:"skip"`
+	Limit  uint16    `url:"limit"`
+	Status string    `url:"status,omitempty"`
 }
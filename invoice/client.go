@@ -98,6 +98,31 @@ func (c *Client) FetchInvoices(ctx context.Context, opt durianpay.InvoiceFetchOp
 	return &res.Data, nil
 }
 
+// IterateInvoices returns iterator over every invoice of List Invoices API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateInvoices(ctx context.Context, opt durianpay.InvoiceFetchOption) *common.Iterator[Invoices] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[Invoices] {
+		opt := opt
+		opt.Range = window
+
+		return func(ctx context.Context, skip, limit int) (common.Page[Invoices], *durianpay.Error) {
+			_, limit16, err := common.PageUint16(0, limit)
+			if err != nil {
+				return common.Page[Invoices]{}, err
+			}
+			opt.Skip, opt.Limit = uint32(skip), limit16
+
+			res, err := c.FetchInvoices(ctx, opt)
+			if err != nil {
+				return common.Page[Invoices]{}, err
+			}
+
+			return common.Page[Invoices]{Items: res.Invoices, Total: res.TotalCount}, nil
+		}
+	})
+}
+
 // Update returns a response from Update Invoice API
 //
 //	[Doc Update Invoice API]: https://durianpay.id/docs/api/invoices/update/
@@ -12,7 +12,6 @@ import (
 	"reflect"
 	"strings"
 	"testing"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 	"github.com/abmid/dpay-sdk-go/internal/tests"
@@ -50,8 +49,8 @@ func TestClient_Create(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				payload: durianpay.InvoiceCreatePayload{
-					Amount:          "20000.67",
-					RemainingAmount: "5000.67",
+					Amount:          durianpay.MustParseAmount("20000.67"),
+					RemainingAmount: durianpay.MustParseAmount("5000.67"),
 					Title:           "sample",
 					InvoiceRefID:    "inv_ref_001",
 					Customer: durianpay.Customer{
@@ -97,8 +96,8 @@ func TestClient_Create(t *testing.T) {
 				InvoiceRefID:             "inv_ref_001",
 				Title:                    "sample",
 				Status:                   "outstanding",
-				Amount:                   "20001",
-				RemainingAmount:          "5000.67",
+				Amount:                   durianpay.NewAmount(20001),
+				RemainingAmount:          durianpay.MustParseAmount("5000.67"),
 				DueDate:                  tests.StringToTime("2023-09-19T10:00:00Z"),
 				StartDate:                tests.StringToTime("2023-09-18T10:00:00Z"),
 				CreatedAt:                tests.StringToTime("2023-09-17T05:06:41.816313Z"),
@@ -283,8 +282,8 @@ func TestClient_FetchInvoiceByID(t *testing.T) {
 				IsLive:                      false,
 				Title:                       "sample",
 				Status:                      "outstanding",
-				Amount:                      "20001",
-				RemainingAmount:             "5001",
+				Amount:                      durianpay.NewAmount(20001),
+				RemainingAmount:             durianpay.NewAmount(5001),
 				StartDate:                   tests.StringToTime("2023-09-18T10:00:00Z"),
 				DueDate:                     tests.StringToTime("2023-09-19T10:00:00Z"),
 				CreatedAt:                   tests.StringToTime("2023-09-17T05:06:41.816313Z"),
@@ -295,7 +294,7 @@ func TestClient_FetchInvoiceByID(t *testing.T) {
 				Transactions: []Transaction{
 					{
 						ID:     "inv_txn_sAMwRDEqcE0554",
-						Amount: "15000",
+						Amount: durianpay.NewAmount(15000),
 						Status: "paid_manually",
 					},
 				},
@@ -363,8 +362,7 @@ func TestClient_FetchInvoices(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.InvoiceFetchOption{
-					From:   time.Now().Format("2006-01-02"),
-					To:     time.Now().Format("2006-01-02"),
+					Range:  durianpay.Today(),
 					Skip:   1,
 					Limit:  10,
 					Status: "paid",
@@ -397,8 +395,8 @@ func TestClient_FetchInvoices(t *testing.T) {
 						PartialTransactionConfig: map[string]any{
 							"min_acceptable_amount": 10000,
 						},
-						Amount:          "20001",
-						RemainingAmount: "5001",
+						Amount:          durianpay.NewAmount(20001),
+						RemainingAmount: durianpay.NewAmount(5001),
 						IsLive:          false,
 						IsBlocked:       false,
 					},
@@ -468,7 +466,7 @@ func TestClient_Update(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.InvoiceUpdatePayload{
 					InvoiceRefID:             "inv_ref_001",
-					RemainingAmount:          "5000.67",
+					RemainingAmount:          durianpay.MustParseAmount("5000.67"),
 					Title:                    "sample invoice",
 					EnablePartialTransaction: true,
 					PartialTransactionConfig: map[string]any{
@@ -501,8 +499,8 @@ func TestClient_Update(t *testing.T) {
 				CustomerID:               "cus_xWI6twzZbr7065",
 				Title:                    "sample invoice",
 				Status:                   "outstanding",
-				Amount:                   "20001",
-				RemainingAmount:          "5001",
+				Amount:                   durianpay.NewAmount(20001),
+				RemainingAmount:          durianpay.NewAmount(5001),
 				StartDate:                tests.StringToTime("2023-09-19T00:00:00Z"),
 				DueDate:                  tests.StringToTime("2023-09-20T00:00:00Z"),
 				CreatedAt:                tests.StringToTime("2023-09-17T05:06:41.816313Z"),
@@ -591,11 +589,11 @@ func TestClient_Pay(t *testing.T) {
 					Invoices: []durianpay.Invoice{
 						{
 							ID:                "inv_0dIWbudjjf84078",
-							TransactionAmount: "10000.34",
+							TransactionAmount: durianpay.MustParseAmount("10000.34"),
 						},
 						{
 							ID:                "inv_h73BiiJVS42949",
-							TransactionAmount: "12002.00",
+							TransactionAmount: durianpay.MustParseAmount("12002.00"),
 						},
 					},
 					CustomerID: "cus_8OZPaCrlfV6309",
@@ -615,7 +613,7 @@ func TestClient_Pay(t *testing.T) {
 			},
 			wantRes: &Pay{
 				VANumber:             "4041600154000000",
-				Amount:               "250002",
+				Amount:               durianpay.NewAmount(250002),
 				BankCode:             "BCA",
 				InvoiceTransactionID: "inv_txn_Fp7St3HycX8335",
 			},
@@ -689,7 +687,7 @@ func TestClient_ManualPay(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.InvoiceManualPayPayload{
 					ID:     "inv_0dIWbudjjf84078",
-					Amount: "10000.23",
+					Amount: durianpay.MustParseAmount("10000.23"),
 				},
 			},
 			prepare: func(m mocks, args args) {
@@ -830,3 +828,44 @@ func TestClient_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_IterateInvoices(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	// Both pages are full, only TotalCount stops the iterator before the third request
+	pages := map[uint32]FetchInvoices{
+		0: {Invoices: []Invoices{{ID: "inv_1"}, {ID: "inv_2"}}, TotalCount: 4},
+		2: {Invoices: []Invoices{{ID: "inv_3"}, {ID: "inv_4"}}, TotalCount: 4},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.InvoiceFetchOption{Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", urlInvoice, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				response.(*struct {
+					Data FetchInvoices `json:"data"`
+				}).Data = page
+				return nil
+			})
+	}
+
+	it := c.IterateInvoices(context.Background(), durianpay.InvoiceFetchOption{Limit: 2})
+
+	gotIDs := []string{}
+	for it.Next() {
+		gotIDs = append(gotIDs, it.Item().ID)
+	}
+
+	if it.Err() != nil || !reflect.DeepEqual(gotIDs, []string{"inv_1", "inv_2", "inv_3", "inv_4"}) || it.Page().Total != 4 {
+		t.Errorf("Client.IterateInvoices() = %v, total %v, %v", gotIDs, it.Page().Total, it.Err())
+	}
+}
@@ -6,7 +6,10 @@
  */
 package durianpay
 
-import "time"
+import (
+	"encoding/json"
+	"time"
+)
 
 /*
  Payloads
@@ -14,36 +17,53 @@ import "time"
 
 // OrderPayload is payload for requests Create Orders API
 type OrderPayload struct {
-	Amount        string         `json:"amount"`
-	PaymentOption string         `json:"payment_option"`
-	Currency      string         `json:"currency"`
-	OrderRefID    string         `json:"order_ref_id"`
+	Amount        Amount         `json:"amount" validate:"required,amount"`
+	PaymentOption string         `json:"payment_option" validate:"oneof=full_payment installment"`
+	Currency      string         `json:"currency" validate:"required,oneof=IDR"`
+	OrderRefID    string         `json:"order_ref_id" validate:"max=255"`
 	Customer      Customer       `json:"customer"`
 	Items         []OrderItem    `json:"items"`
 	Metadata      map[string]any `json:"metadata"`
-	ExpiryDate    time.Time      `json:"expiry_date"`
+	ExpiryDate    time.Time      `json:"expiry_date"` // Zero value is not sent
+	ShippingFee   Amount         `json:"-"`           // Not sent, only checked against Amount by ValidateOrder
+	Fees          Amount         `json:"-"`           // Not sent, only checked against Amount by ValidateOrder
+}
+
+// MarshalJSON implements json.Marshaler, zero ExpiryDate is omitted instead of sent as year 1.
+func (p OrderPayload) MarshalJSON() ([]byte, error) {
+	type payload OrderPayload
+
+	var expiryDate *time.Time
+	if !p.ExpiryDate.IsZero() {
+		expiryDate = &p.ExpiryDate
+	}
+
+	return json.Marshal(struct {
+		payload
+		ExpiryDate *time.Time `json:"expiry_date,omitempty"`
+	}{payload(p), expiryDate})
 }
 
 // OrderItem is part of CreatePayload for attribute Items
 type OrderItem struct {
-	Name  string `json:"name"`
-	Qty   uint16 `json:"qty"`
-	Price string `json:"price"`
-	Logo  string `json:"logo"`
+	Name  string `json:"name" validate:"required,max=255"`
+	Qty   uint16 `json:"qty" validate:"required"`
+	Price Amount `json:"price" validate:"amount"`
+	Logo  string `json:"logo" validate:"url"`
 }
 
 // OrderPaymentLinkPayload is payload for requests Create Payment Link API
 type OrderPaymentLinkPayload struct {
-	Amount        string                   `json:"amount"`
-	Currency      string                   `json:"currency"`
-	OrderRefID    string                   `json:"order_ref_id"`
+	Amount        Amount                   `json:"amount" validate:"required,amount"`
+	Currency      string                   `json:"currency" validate:"required,oneof=IDR"`
+	OrderRefID    string                   `json:"order_ref_id" validate:"max=255"`
 	IsPaymentLink bool                     `json:"is_payment_link"`
 	Customer      OrderPaymentLinkCustomer `json:"customer"`
 }
 
 // OrderPaymentLinkCustomer is part of OrderPaymentLinkPayload
 type OrderPaymentLinkCustomer struct {
-	Email string `json:"email"`
+	Email string `json:"email" validate:"required,email"`
 }
 
 /*
@@ -52,10 +72,9 @@ type OrderPaymentLinkCustomer struct {
 
 // OrderFetchOption is parameter for requests Orders Fetch API
 type OrderFetchOption struct {
-	From  string `url:"form"`
-	To    string `url:"to"`
-	Skip  uint16 `url:"skip"`
-	Limit uint16 `url:"limit"`
+	Range DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip  uint16    `url:"skip"`
+	Limit uint16    `url:"limit"`
 }
 
 // OrderFetchByIDOption is parameter for requests Order Fetch By ID API
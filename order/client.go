@@ -26,6 +26,7 @@ const (
 )
 
 // Create returns a response from Create Order API.
+// Payload is checked with durianpay.ValidateOrder by Api after phone numbers are normalized, see common.ApiImplement.Req
 //
 //	[Doc Create Order API]: https://durianpay.id/docs/api/orders/create/
 func (c *Client) Create(ctx context.Context, payload durianpay.OrderPayload) (*Create, *durianpay.Error) {
@@ -58,6 +59,30 @@ func (c *Client) FetchOrders(ctx context.Context, opt durianpay.OrderFetchOption
 	return &res.Data, nil
 }
 
+// IterateOrders returns iterator over every order of Orders Fetch API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateOrders(ctx context.Context, opt durianpay.OrderFetchOption) *common.Iterator[Orders] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[Orders] {
+		opt := opt
+		opt.Range = window
+
+		return func(ctx context.Context, skip, limit int) (common.Page[Orders], *durianpay.Error) {
+			var err *durianpay.Error
+			if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+				return common.Page[Orders]{}, err
+			}
+
+			res, err := c.FetchOrders(ctx, opt)
+			if err != nil {
+				return common.Page[Orders]{}, err
+			}
+
+			return common.Page[Orders]{Items: res.Orders, Total: int(res.Count)}, nil
+		}
+	})
+}
+
 // FetchOrderByID returns a response from Order Fetch By ID API.
 //
 //	[Doc Order Fetch By ID API]: https://durianpay.id/docs/api/orders/fetch-one/
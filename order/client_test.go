@@ -33,6 +33,44 @@ func TestClient_Create(t *testing.T) {
 	featureWrap := tests.FeatureWrap(t)
 	defer featureWrap.Ctrl.Finish()
 
+	payload := durianpay.OrderPayload{
+		Amount:        durianpay.MustParseAmount("10001.00"),
+		PaymentOption: "full_payment",
+		Currency:      "IDR",
+		OrderRefID:    "order_ref_001",
+		Customer: durianpay.Customer{
+			CustomerRefID: "cust_001",
+			GivenName:     "Jane Doe",
+			Email:         "jane_doe@nomail.com",
+			Mobile:        "85722173217",
+			Address: durianpay.CustomerAddress{
+				ReceiverName:  "Jude Casper",
+				ReceiverPhone: "8987654321",
+				Label:         "Home Address",
+				AddressLine1:  "Jl. HR. Rasuna Said",
+				AddressLine2:  "Apartment #786",
+				City:          "Jakarta Selatan",
+				Region:        "Jakarta",
+				Country:       "Indonesia",
+				PostalCode:    "560008",
+				Landmark:      "Kota Jakarta Selatan",
+			},
+		},
+		Items: []durianpay.OrderItem{
+			{
+				Name:  "LED Television",
+				Qty:   1,
+				Price: durianpay.MustParseAmount("10001.00"),
+				Logo:  "https://merchant.com/tv_image.jpg",
+			},
+		},
+		Metadata: map[string]any{
+			"my-meta-key":     "my-meta-value",
+			"SettlementGroup": "BranchName",
+		},
+		ExpiryDate: tests.StringToTime("2099-03-29T10:00:00.000Z"),
+	}
+
 	type args struct {
 		ctx     context.Context
 		payload durianpay.OrderPayload
@@ -47,44 +85,8 @@ func TestClient_Create(t *testing.T) {
 		{
 			name: "Success",
 			args: args{
-				ctx: context.TODO(),
-				payload: durianpay.OrderPayload{
-					Amount:        "10000.67",
-					PaymentOption: "full_payment",
-					Currency:      "IDR",
-					OrderRefID:    "order_ref_001",
-					Customer: durianpay.Customer{
-						CustomerRefID: "cust_001",
-						GivenName:     "Jane Doe",
-						Email:         "jane_doe@nomail.com",
-						Mobile:        "85722173217",
-						Address: durianpay.CustomerAddress{
-							ReceiverName:  "Jude Casper",
-							ReceiverPhone: "8987654321",
-							Label:         "Home Address",
-							AddressLine1:  "Jl. HR. Rasuna Said",
-							AddressLine2:  "Apartment #786",
-							City:          "Jakarta Selatan",
-							Region:        "Jakarta",
-							Country:       "Indonesia",
-							PostalCode:    "560008",
-							Landmark:      "Kota Jakarta Selatan",
-						},
-					},
-					Items: []durianpay.OrderItem{
-						{
-							Name:  "LED Television",
-							Qty:   1,
-							Price: "10001.00",
-							Logo:  "https://merchant.com/tv_image.jpg",
-						},
-					},
-					Metadata: map[string]any{
-						"my-meta-key":     "my-meta-value",
-						"SettlementGroup": "BranchName",
-					},
-					ExpiryDate: tests.StringToTime("2022-03-29T10:00:00.000Z"),
-				},
+				ctx:     context.TODO(),
+				payload: payload,
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
@@ -102,7 +104,7 @@ func TestClient_Create(t *testing.T) {
 				ID:            "ord_0dIWbuDJQ84078",
 				CustomerID:    "cus_ViPeX4iBYp2233",
 				OrderRefID:    "order_ref_001",
-				Amount:        "10001.00",
+				Amount:        durianpay.MustParseAmount("10001.00"),
 				PaymentOption: "full_payment",
 				Currency:      "IDR",
 				Status:        "started",
@@ -117,7 +119,7 @@ func TestClient_Create(t *testing.T) {
 					{
 						Name:  "LED Television",
 						Qty:   1,
-						Price: "10001.00",
+						Price: durianpay.MustParseAmount("10001.00"),
 						Logo:  "https://merchant.com/tv_image.jpg",
 					},
 				},
@@ -130,7 +132,8 @@ func TestClient_Create(t *testing.T) {
 		{
 			name: "Internal Server Error",
 			args: args{
-				ctx: context.Background(),
+				ctx:     context.Background(),
+				payload: payload,
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
@@ -206,7 +209,7 @@ func TestClient_FetchOrders(t *testing.T) {
 					{
 						ID:                    "ord_jcI3YWlYbD5367",
 						CustomerID:            "cus_IwDIb0MDY20938",
-						Amount:                "10000.00",
+						Amount:                durianpay.MustParseAmount("10000.00"),
 						Currency:              "IDR",
 						Status:                "completed",
 						IsLive:                false,
@@ -266,6 +269,46 @@ func TestClient_FetchOrders(t *testing.T) {
 	}
 }
 
+func TestClient_IterateOrders(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	pages := map[uint16]FetchOrders{
+		0: {Orders: []Orders{{ID: "ord_1"}, {ID: "ord_2"}}, Count: 3},
+		2: {Orders: []Orders{{ID: "ord_3"}}, Count: 3},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.OrderFetchOption{Range: durianpay.LastNDays(30), Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", durianpay.DurianpayURL+pathOrder, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				response.(*struct {
+					Data FetchOrders `json:"data"`
+				}).Data = page
+				return nil
+			})
+	}
+
+	it := c.IterateOrders(context.Background(), durianpay.OrderFetchOption{Range: durianpay.LastNDays(30), Limit: 2})
+
+	gotIDs := []string{}
+	for it.Next() {
+		gotIDs = append(gotIDs, it.Item().ID)
+	}
+
+	if it.Err() != nil || !reflect.DeepEqual(gotIDs, []string{"ord_1", "ord_2", "ord_3"}) {
+		t.Errorf("Client.IterateOrders() = %v, %v", gotIDs, it.Err())
+	}
+}
+
 func TestClient_FetchOrderByID(t *testing.T) {
 	featureWrap := tests.FeatureWrap(t)
 	defer featureWrap.Ctrl.Finish()
@@ -304,7 +347,7 @@ func TestClient_FetchOrderByID(t *testing.T) {
 				ID:                    "ord_wNSShKTAsL1204",
 				CustomerID:            "cus_dkRHbkDXrn2354",
 				OrderRefID:            "order_ref_8",
-				Amount:                "80000.00",
+				Amount:                durianpay.MustParseAmount("80000.00"),
 				Currency:              "IDR",
 				Status:                "completed",
 				IsLive:                false,
@@ -315,7 +358,7 @@ func TestClient_FetchOrderByID(t *testing.T) {
 				Payments: []Payment{
 					{
 						ID:                 "pay_sample_5b2tSNVDXn5148",
-						Amount:             "80000.00",
+						Amount:             durianpay.MustParseAmount("80000.00"),
 						Status:             "completed",
 						IsLive:             false,
 						ExpirationDate:     tests.StringToTime("2023-07-27T04:12:30.887217Z"),
@@ -389,7 +432,7 @@ func TestClient_CreatePaymentLink(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				payload: durianpay.OrderPaymentLinkPayload{
-					Amount:        "20000",
+					Amount:        durianpay.NewAmount(20000),
 					Currency:      "IDR",
 					OrderRefID:    "order2314",
 					IsPaymentLink: true,
@@ -414,7 +457,7 @@ func TestClient_CreatePaymentLink(t *testing.T) {
 				ID:             "ord_n7WUecCLkz5074",
 				CustomerID:     "cus_0l6ZMxd9cW6365",
 				OrderRefID:     "order_ref_001",
-				Amount:         "10001.00",
+				Amount:         durianpay.MustParseAmount("10001.00"),
 				Currency:       "IDR",
 				Status:         "started",
 				IsLive:         false,
@@ -19,9 +19,9 @@ type Create struct {
 	CustomerID     string                `json:"customer_id"`
 	OrderRefID     string                `json:"order_ref_id"`
 	OrderDsRefID   string                `json:"order_ds_ref_id"`
-	Amount         string                `json:"amount"`
+	Amount         durianpay.Amount      `json:"amount"`
 	PaymentOption  string                `json:"payment_option"`
-	PendingAmount  string                `json:"pending_amount"`
+	PendingAmount  durianpay.Amount      `json:"pending_amount"`
 	Currency       string                `json:"currency"`
 	Status         string                `json:"status"`
 	IsLive         bool                  `json:"is_live"`
@@ -34,8 +34,8 @@ type Create struct {
 	ExpiryDate     time.Time             `json:"expiry_date"`
 	PaymentLinkUrl string                `json:"payment_link_url"`
 	AddressID      uint32                `json:"address_id"`
-	Fees           string                `json:"fees"`
-	ShippingFee    string                `json:"shipping_fee"`
+	Fees           durianpay.Amount      `json:"fees"`
+	ShippingFee    durianpay.Amount      `json:"shipping_fee"`
 	AdminFeeMethod string                `json:"admin_fee_method"`
 }
 
@@ -47,32 +47,32 @@ type FetchOrders struct {
 
 // Orders is part of FetchOrders for attribute Orders
 type Orders struct {
-	ID                    string    `json:"id"`
-	CustomerID            string    `json:"customer_id"`
-	OrderRefID            string    `json:"order_ref_id"`
-	OrderDsRefID          string    `json:"order_ds_ref_id"`
-	Amount                string    `json:"amount"`
-	Currency              string    `json:"currency"`
-	Status                string    `json:"status"`
-	IsLive                bool      `json:"is_live"`
-	CreatedAt             time.Time `json:"created_at"`
-	UpdatedAt             time.Time `json:"updated_at"`
-	ExpiryDate            time.Time `json:"expiry_date"`
-	GivenName             string    `json:"given_name"`
-	SurName               string    `json:"sur_name"`
-	Email                 string    `json:"email"`
-	Mobile                string    `json:"mobile"`
-	PaymentOption         string    `json:"payment_option"`
-	PaymentID             string    `json:"payment_id"`
-	PaymentDetailsType    string    `json:"payment_details_type"`
-	PaymentStatus         string    `json:"payment_status"`
-	PaymentDate           time.Time `json:"payment_date"`
-	Description           string    `json:"description"`
-	PaymentLinkUrl        string    `json:"payment_link_url"`
-	IsNotificationEnabled bool      `json:"is_notification_enabled"`
-	EmailSubject          string    `json:"email_subject"`
-	EmailContent          string    `json:"email_content"`
-	PaymentMethodID       string    `json:"payment_method_id"`
+	ID                    string           `json:"id"`
+	CustomerID            string           `json:"customer_id"`
+	OrderRefID            string           `json:"order_ref_id"`
+	OrderDsRefID          string           `json:"order_ds_ref_id"`
+	Amount                durianpay.Amount `json:"amount"`
+	Currency              string           `json:"currency"`
+	Status                string           `json:"status"`
+	IsLive                bool             `json:"is_live"`
+	CreatedAt             time.Time        `json:"created_at"`
+	UpdatedAt             time.Time        `json:"updated_at"`
+	ExpiryDate            time.Time        `json:"expiry_date"`
+	GivenName             string           `json:"given_name"`
+	SurName               string           `json:"sur_name"`
+	Email                 string           `json:"email"`
+	Mobile                string           `json:"mobile"`
+	PaymentOption         string           `json:"payment_option"`
+	PaymentID             string           `json:"payment_id"`
+	PaymentDetailsType    string           `json:"payment_details_type"`
+	PaymentStatus         string           `json:"payment_status"`
+	PaymentDate           time.Time        `json:"payment_date"`
+	Description           string           `json:"description"`
+	PaymentLinkUrl        string           `json:"payment_link_url"`
+	IsNotificationEnabled bool             `json:"is_notification_enabled"`
+	EmailSubject          string           `json:"email_subject"`
+	EmailContent          string           `json:"email_content"`
+	PaymentMethodID       string           `json:"payment_method_id"`
 }
 
 // FetchOrder is struct for response Fetch Order API
@@ -81,9 +81,9 @@ type FetchOrder struct {
 	CustomerID            string                `json:"customer_id"`
 	OrderRefID            string                `json:"order_ref_id"`
 	OrderDsRefID          string                `json:"order_ds_ref_id"`
-	Amount                string                `json:"amount"`
+	Amount                durianpay.Amount      `json:"amount"`
 	PaymentOption         string                `json:"payment_option"`
-	PendingAmount         string                `json:"pending_amount"`
+	PendingAmount         durianpay.Amount      `json:"pending_amount"`
 	Currency              string                `json:"currency"`
 	Status                string                `json:"status"`
 	IsLive                bool                  `json:"is_live"`
@@ -97,8 +97,8 @@ type FetchOrder struct {
 	IsNotificationEnabled bool                  `json:"is_notification_enabled"`
 	EmailSubject          string                `json:"email_subject"`
 	EmailContent          string                `json:"email_content"`
-	Fees                  string                `json:"fees"`
-	ShippingFee           string                `json:"shipping_fee"`
+	Fees                  durianpay.Amount      `json:"fees"`
+	ShippingFee           durianpay.Amount      `json:"shipping_fee"`
 	AdminFeeMethod        string                `json:"admin_fee_method"`
 	Customer              durianpay.Customer    `json:"customer"` // Will be filled if use query expand=customer
 	Payments              []Payment             `json:"payments"` // Will be filled if use query expand=payments
@@ -106,28 +106,28 @@ type FetchOrder struct {
 
 // Payment is part of FetchOrder for attribute Payments
 type Payment struct {
-	ID                 string         `json:"id"`
-	OrderID            string         `json:"order_id"`
-	PaymentRefID       string         `json:"payment_ref_id"`
-	SettlementID       string         `json:"settlement_id"`
-	PaymentDsRefID     string         `json:"payment_ds_ref_id"`
-	Amount             string         `json:"amount"`
-	Status             string         `json:"status"`
-	IsLive             bool           `json:"is_live"`
-	ExpirationDate     time.Time      `json:"expiration_date"`
-	PaymentDetailsType string         `json:"payment_details_type"`
-	MethodID           string         `json:"method_id"`
-	CreatedAt          time.Time      `json:"created_at"`
-	UpdatedAt          time.Time      `json:"updated_at"`
-	Metadata           map[string]any `json:"metadata"`
-	RetryCount         uint16         `json:"retry_count"`
-	Discount           string         `json:"discount"`
-	PaidAmount         string         `json:"paid_amount"`
-	ProvideID          string         `json:"provider_id"`
-	TotalFee           string         `json:"total_fee"`
-	PromoID            string         `json:"promo_id"`
-	ShippingFee        string         `json:"shipping_fee"`
-	SettlementStatus   string         `json:"settlement_status"`
-	DsErrorMetadata    map[string]any `json:"ds_error_metadata"`
-	FailureReason      map[string]any `json:"failure_reason"`
+	ID                 string           `json:"id"`
+	OrderID            string           `json:"order_id"`
+	PaymentRefID       string           `json:"payment_ref_id"`
+	SettlementID       string           `json:"settlement_id"`
+	PaymentDsRefID     string           `json:"payment_ds_ref_id"`
+	Amount             durianpay.Amount `json:"amount"`
+	Status             string           `json:"status"`
+	IsLive             bool             `json:"is_live"`
+	ExpirationDate     time.Time        `json:"expiration_date"`
+	PaymentDetailsType string           `json:"payment_details_type"`
+	MethodID           string           `json:"method_id"`
+	CreatedAt          time.Time        `json:"created_at"`
+	UpdatedAt          time.Time        `json:"updated_at"`
+	Metadata           map[string]any   `json:"metadata"`
+	RetryCount         uint16           `json:"retry_count"`
+	Discount           durianpay.Amount `json:"discount"`
+	PaidAmount         durianpay.Amount `json:"paid_amount"`
+	ProvideID          string           `json:"provider_id"`
+	TotalFee           durianpay.Amount `json:"total_fee"`
+	PromoID            string           `json:"promo_id"`
+	ShippingFee        durianpay.Amount `json:"shipping_fee"`
+	SettlementStatus   string           `json:"settlement_status"`
+	DsErrorMetadata    map[string]any   `json:"ds_error_metadata"`
+	FailureReason      map[string]any   `json:"failure_reason"`
 }
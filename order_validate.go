@@ -0,0 +1,69 @@
+/*
+ * File Created: Monday, 19th October 2026 12:48:45 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ValidateOrder checks consistency of payload before it is sent to Create Order API, in addition to `validate` tags:
+//
+//   - Amount equals total of Price * Qty of Items plus ShippingFee & Fees, when Items is not empty
+//   - ExpiryDate is after now, when it is set
+//   - OrderRefID & customer email are present, customer mobile is a valid Indonesian mobile number
+//
+// Validate calls ValidateOrder for OrderPayload. It returns nil if payload is valid, otherwise Error with ErrorCodeSDKValidation and every invalid field in Errors.
+func ValidateOrder(payload OrderPayload, now time.Time) *Error {
+	errs := []Errors{}
+	if err := validateTags(payload); err != nil {
+		// Invalid validate tag has no field errors
+		if len(err.Errors) == 0 {
+			return err
+		}
+
+		errs = append(errs, err.Errors...)
+	}
+
+	if strings.TrimSpace(payload.OrderRefID) == "" {
+		errs = append(errs, Errors{Field: "order_ref_id", Message: "order_ref_id is required"})
+	}
+
+	if total := orderTotal(payload); len(payload.Items) > 0 && !payload.Amount.IsZero() && total != payload.Amount {
+		errs = append(errs, Errors{
+			Field:   "amount",
+			Message: fmt.Sprintf("amount must be %s, total of items, shipping_fee & fees", total.Compact()),
+		})
+	}
+
+	if !payload.ExpiryDate.IsZero() && !payload.ExpiryDate.After(now) {
+		errs = append(errs, Errors{Field: "expiry_date", Message: "expiry_date must be in the future"})
+	}
+
+	if strings.TrimSpace(payload.Customer.Email) == "" {
+		errs = append(errs, Errors{Field: "customer.email", Message: "email is required"})
+	}
+
+	if mobile := payload.Customer.Mobile; strings.TrimSpace(mobile) != "" {
+		if _, err := ParsePhone(mobile); err != nil {
+			errs = append(errs, Errors{Field: "customer.mobile", Message: "mobile must be a valid Indonesian mobile number"})
+		}
+	}
+
+	return FromValidationErrors("invalid order", errs)
+}
+
+// orderTotal returns total of Price * Qty of items plus ShippingFee & Fees.
+func orderTotal(payload OrderPayload) Amount {
+	total := payload.ShippingFee.Add(payload.Fees)
+	for _, item := range payload.Items {
+		total = total.Add(item.Price.Mul(int64(item.Qty)))
+	}
+
+	return total
+}
@@ -0,0 +1,122 @@
+/*
+ * File Created: Monday, 19th October 2026 12:48:45 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateOrder(t *testing.T) {
+	now := time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC)
+
+	valid := OrderPayload{
+		Amount:     NewAmount(25500),
+		Currency:   "IDR",
+		OrderRefID: "order_ref_001",
+		Customer:   Customer{Email: "jane_doe@nomail.com", Mobile: "085722173217"},
+		Items: []OrderItem{
+			{Name: "Durian", Qty: 2, Price: NewAmount(10000)},
+			{Name: "Box", Qty: 1, Price: NewAmount(3000)},
+		},
+		ShippingFee: NewAmount(2000),
+		Fees:        MustParseAmount("500"),
+		ExpiryDate:  now.Add(time.Hour),
+	}
+
+	tests := []struct {
+		name       string
+		payload    func(p OrderPayload) OrderPayload
+		wantErrors []Errors
+	}{
+		{
+			name:    "Valid",
+			payload: func(p OrderPayload) OrderPayload { return p },
+		},
+		{
+			name: "Without items & expiry date",
+			payload: func(p OrderPayload) OrderPayload {
+				p.Items, p.ExpiryDate = nil, time.Time{}
+				return p
+			},
+		},
+		{
+			name: "Amount does not match items",
+			payload: func(p OrderPayload) OrderPayload {
+				p.Fees = Amount{}
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "amount", Message: "amount must be 25000, total of items, shipping_fee & fees"},
+			},
+		},
+		{
+			name: "Expired, missing reference & customer",
+			payload: func(p OrderPayload) OrderPayload {
+				p.OrderRefID = " "
+				p.ExpiryDate = now
+				p.Customer = Customer{Mobile: "021-555-1234"}
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "order_ref_id", Message: "order_ref_id is required"},
+				{Field: "expiry_date", Message: "expiry_date must be in the future"},
+				{Field: "customer.email", Message: "email is required"},
+				{Field: "customer.mobile", Message: "mobile must be a valid Indonesian mobile number"},
+			},
+		},
+		{
+			name: "Currency from validate tags",
+			payload: func(p OrderPayload) OrderPayload {
+				p.Currency = "USD"
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "currency", Message: "currency must be one of IDR"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateOrder(tt.payload(valid), now)
+			if tt.wantErrors == nil {
+				if got != nil {
+					t.Errorf("ValidateOrder() = %v, want nil", got.Errors)
+				}
+				return
+			}
+
+			if got == nil || got.ErrorCode != ErrorCodeSDKValidation {
+				t.Fatalf("ValidateOrder() = %v, want validation error", got)
+			}
+
+			if !reflect.DeepEqual(got.Errors, tt.wantErrors) {
+				t.Errorf("ValidateOrder() Errors = %v, want %v", got.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestOrderPayload_MarshalJSON(t *testing.T) {
+	b, err := json.Marshal(OrderPayload{Amount: NewAmount(10000), ShippingFee: NewAmount(1000)})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	if strings.Contains(string(b), "expiry_date") || strings.Contains(string(b), "ShippingFee") {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+
+	b, _ = json.Marshal(OrderPayload{ExpiryDate: time.Date(2026, 10, 20, 10, 0, 0, 0, time.UTC)})
+	if !strings.Contains(string(b), `"expiry_date":"2026-10-20T10:00:00Z"`) {
+		t.Errorf("json.Marshal() = %s", b)
+	}
+}
@@ -13,10 +13,10 @@ Payloads
 // PaymentChargeVAPayload is requests payload for Payment Charge API.
 // This request for type `VA`
 type PaymentChargeVAPayload struct {
-	OrderID       string                `json:"order_id"`
-	BankCode      string                `json:"bank_code"`
-	Name          string                `json:"name"`
-	Amount        string                `json:"amount"`
+	OrderID       string                `json:"order_id" validate:"required"`
+	BankCode      string                `json:"bank_code" validate:"required"`
+	Name          string                `json:"name" validate:"required,max=255"`
+	Amount        Amount                `json:"amount" validate:"required,amount"`
 	PaymentRefID  string                `json:"payment_ref_id"`
 	SandboxOption *PaymentSandboxOption // If you want send request as Sandbox use this option
 }
@@ -24,20 +24,20 @@ type PaymentChargeVAPayload struct {
 // PaymentChargeEwalletPayload is requests payload for Payment Charge API.
 // This request for type E-WALLET
 type PaymentChargeEwalletPayload struct {
-	OrderID       string                `json:"order_id"`
-	Amount        string                `json:"amount"`
-	Mobile        string                `json:"mobile"`
-	WalletType    string                `json:"wallet_type"`
+	OrderID       string                `json:"order_id" validate:"required"`
+	Amount        Amount                `json:"amount" validate:"required,amount"`
+	Mobile        string                `json:"mobile" validate:"required" phone:""`
+	WalletType    string                `json:"wallet_type" validate:"required,oneof=OVO DANA SHOPEEPAY LINKAJA GOPAY"`
 	SandboxOption *PaymentSandboxOption `json:"-"` // If you want send request as Sandbox use this option
 }
 
 // PaymentChargeRetailStorePayload is requests payload for Payment Charge API.
 // This request for type `Retail Store`
 type PaymentChargeRetailStorePayload struct {
-	OrderID       string                `json:"order_id"`
-	BankCode      string                `json:"bank_code"`
-	Name          string                `json:"name"`
-	Amount        string                `json:"amount"`
+	OrderID       string                `json:"order_id" validate:"required"`
+	BankCode      string                `json:"bank_code" validate:"required,oneof=ALFAMART INDOMARET"`
+	Name          string                `json:"name" validate:"required,max=255"`
+	Amount        Amount                `json:"amount" validate:"required,amount"`
 	PaymentRefID  string                `json:"payment_ref_id"`
 	SandboxOption *PaymentSandboxOption `json:"-"` // If you want send request as Sandbox use this option
 }
@@ -45,17 +45,17 @@ type PaymentChargeRetailStorePayload struct {
 // PaymentChargeOnlineBankingPayload is requests payload for Payment Charge API.
 // This requests for type `Online Banking`
 type PaymentChargeOnlineBankingPayload struct {
-	OrderID      string              `json:"order_id"`
-	Type         string              `json:"type"`
-	Name         string              `json:"name"`
-	Amount       string              `json:"amount"`
+	OrderID      string              `json:"order_id" validate:"required"`
+	Type         string              `json:"type" validate:"required"`
+	Name         string              `json:"name" validate:"max=255"`
+	Amount       Amount              `json:"amount" validate:"required,amount"`
 	CustomerInfo PaymentCustomerInfo `json:"customer_info"`
-	Mobile       string              `json:"mobile"`
+	Mobile       string              `json:"mobile" phone:""`
 }
 
 // PaymentCustomerInfo is part of PaymentRequestOnlineBanking for attribute Customer Info
 type PaymentCustomerInfo struct {
-	Email     string `json:"email"`
+	Email     string `json:"email" validate:"email"`
 	GivenName string `json:"given_name"`
 	ID        string `json:"id"`
 }
@@ -63,17 +63,17 @@ type PaymentCustomerInfo struct {
 // PaymentChargeQRISPayload is requests payload for Payment Charge API.
 // This requests for type `QRIS`
 type PaymentChargeQRISPayload struct {
-	OrderID string `json:"order_id"`
-	Type    string `json:"type"`
-	Amount  string `json:"amount"`
-	Name    string `json:"name"`
+	OrderID string `json:"order_id" validate:"required"`
+	Type    string `json:"type" validate:"required"`
+	Amount  Amount `json:"amount" validate:"required,amount"`
+	Name    string `json:"name" validate:"max=255"`
 }
 
 // PaymentChargeCardPayload is requests payload for Payment Charge API.
 // This requests for type `CARD`
 type PaymentChargeCardPayload struct {
-	OrderID      string              `json:"order_id"`
-	Amount       string              `json:"amount"`
+	OrderID      string              `json:"order_id" validate:"required"`
+	Amount       Amount              `json:"amount" validate:"required,amount"`
 	PaymentRefID string              `json:"payment_ref_id"`
 	CustomerInfo PaymentCustomerInfo `json:"customer_info"`
 }
@@ -81,10 +81,10 @@ type PaymentChargeCardPayload struct {
 // PaymentChargeBNPLPayload is requests payload for Payment Charge API.
 // This requests for `BNPL`
 type PaymentChargeBNPLPayload struct {
-	OrderID               string                `json:"order_id"`
-	Amount                string                `json:"amount"`
+	OrderID               string                `json:"order_id" validate:"required"`
+	Amount                Amount                `json:"amount" validate:"required,amount"`
 	PaymentRefID          string                `json:"payment_ref_id"`
-	PaymentMethodUniqueID string                `json:"payment_method_unique_id"`
+	PaymentMethodUniqueID string                `json:"payment_method_unique_id" validate:"required"`
 	CustomerInfo          PaymentCustomerInfo   `json:"customer_info"`
 	SandboxOption         *PaymentSandboxOption `json:"-"` // If you want send request as Sandbox use this option
 }
@@ -97,12 +97,12 @@ type PaymentSandboxOption struct {
 
 // PaymentVerifyPayload is payload for Verify Payments API
 type PaymentVerifyPayload struct {
-	VerificationSignature string `json:"verification_signature"`
+	VerificationSignature string `json:"verification_signature" validate:"required"`
 }
 
 // PaymentCapturePayload is payload for Payment Capture API
 type PaymentCapturePayload struct {
-	Amount string `json:"amount"`
+	Amount Amount `json:"amount" validate:"required,amount"`
 }
 
 /*
@@ -111,10 +111,9 @@ Options
 
 // PaymentFetchOption is parameter for Payment Fetch API.
 type PaymentFetchOption struct {
-	From  string `url:"from"`
-	To    string `url:"to"`
-	Skip  uint16 `url:"skip"`
-	Limit uint16 `url:"limit"`
+	Range DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip  uint16    `url:"skip"`
+	Limit uint16    `url:"limit"`
 }
 
 // PaymentFetchByIDOption is parameter for Payment Fetch by ID API.
@@ -122,8 +121,13 @@ type PaymentFetchByIDOption struct {
 	Expand string `url:"expand"` // customer or order
 }
 
+// PaymentVerifySignatureOption is option for verify payment signature locally.
+type PaymentVerifySignatureOption struct {
+	RemoteFallback bool // Call Verify Payments API when local verification fails
+}
+
 // PaymentMDRFeesOption is parameter for MDR Fees Calculation API.
 type PaymentMDRFeesOption struct {
-	Amount        string `url:"amount"`
+	Amount        Amount `url:"amount"`
 	PaymentMethod string `url:"payment_method"`
 }
@@ -0,0 +1,212 @@
+/*
+ * File Created: Monday, 19th October 2026 1:35:13 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// NextActionType is what the customer does to complete a charge.
+type NextActionType string
+
+const (
+	NextActionNone     NextActionType = "none"     // Nothing to do, ex: the payment is processed by DurianPay
+	NextActionRedirect NextActionType = "redirect" // Open URL, ex: checkout page of e-wallet or BNPL
+	NextActionTransfer NextActionType = "transfer" // Pay to AccountNumber, ex: Virtual Account or retail store code
+	NextActionScanQR   NextActionType = "scan_qr"  // Scan QRString or QRCode
+)
+
+// NextAction is next step of the customer after a charge.
+type NextAction struct {
+	Type          NextActionType
+	URL           string // Redirect URL
+	AccountNumber string // Virtual Account number or payment code of retail store
+	QRString      string
+	QRCode        string
+}
+
+// ChargeResult is response of Payment Charge API for any payment method, returned by Client.Charge.
+// Use type switch to get the response of the payment method, ex: *ChargeVA.
+type ChargeResult interface {
+	ChargeType() string
+	PaymentID() string
+	OrderID() string
+	// Status returns status of the payment, "processing" when the API does not return it because the payment was just created
+	Status() string
+	// PaidAmount returns amount of the charge
+	PaidAmount() durianpay.Amount
+	// ExpiresAt returns expiration time of the payment, zero when the API does not return it
+	ExpiresAt() time.Time
+	NextAction() NextAction
+}
+
+// chargeStatusProcessing is status of a payment just created by Payment Charge API.
+const chargeStatusProcessing = "processing"
+
+// Charge returns a response from Payment Charge API for any payment method, the type of the charge is taken from req.
+// The result is *ChargeVA for durianpay.PaymentChargeVAPayload, *ChargeEwallet for durianpay.PaymentChargeEwalletPayload and so on.
+//
+//	[Doc Payment Charge API]: https://durianpay.id/docs/api/payments/charge/
+func (c *Client) Charge(ctx context.Context, req durianpay.ChargeRequest) (ChargeResult, *durianpay.Error) {
+	// Pointer to payload implements ChargeRequest too
+	if v := reflect.ValueOf(req); v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil, durianpay.FromSDKError(fmt.Errorf("durianpay: charge request is nil"))
+		}
+		req = v.Elem().Interface().(durianpay.ChargeRequest)
+	}
+
+	var (
+		res ChargeResult
+		err *durianpay.Error
+	)
+
+	switch req := req.(type) {
+	case durianpay.PaymentChargeVAPayload:
+		res, err = asChargeResult(charge[ChargeVA](ctx, c, req))
+	case durianpay.PaymentChargeBNPLPayload:
+		res, err = asChargeResult(charge[ChargeBNPL](ctx, c, req))
+	case durianpay.PaymentChargeEwalletPayload:
+		res, err = asChargeResult(charge[ChargeEwallet](ctx, c, req))
+	case durianpay.PaymentChargeRetailStorePayload:
+		res, err = asChargeResult(charge[ChargeRetailStore](ctx, c, req))
+	case durianpay.PaymentChargeOnlineBankingPayload:
+		res, err = asChargeResult(charge[ChargeOnlineBank](ctx, c, req))
+	case durianpay.PaymentChargeQRISPayload:
+		res, err = asChargeResult(charge[ChargeQRIS](ctx, c, req))
+	case durianpay.PaymentChargeCardPayload:
+		res, err = asChargeResult(charge[ChargeCard](ctx, c, req))
+	default:
+		return nil, durianpay.FromSDKError(fmt.Errorf("durianpay: unsupported charge request %T", req))
+	}
+
+	return res, err
+}
+
+// charge sends req to Payment Charge API and decodes data of the response into T.
+func charge[T any](ctx context.Context, c *Client, req durianpay.ChargeRequest) (*T, *durianpay.Error) {
+	reqPayload := chargePayload{
+		Type:          req.ChargeType(),
+		Request:       req,
+		SandboxOption: req.ChargeSandboxOption(),
+	}
+
+	res := struct {
+		Data T `json:"data"`
+	}{}
+
+	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
+	if err != nil {
+		return nil, err
+	}
+
+	return &res.Data, nil
+}
+
+// asChargeResult returns nil interface instead of nil *T on error.
+func asChargeResult[T any, PT interface {
+	*T
+	ChargeResult
+}](res PT, err *durianpay.Error) (ChargeResult, *durianpay.Error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func statusOrProcessing(status string) string {
+	if status == "" {
+		return chargeStatusProcessing
+	}
+
+	return status
+}
+
+func redirectTo(url string) NextAction {
+	if url == "" {
+		return NextAction{Type: NextActionNone}
+	}
+
+	return NextAction{Type: NextActionRedirect, URL: url}
+}
+
+func (c *ChargeVA) ChargeType() string           { return c.Type }
+func (c *ChargeVA) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeVA) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeVA) Status() string               { return chargeStatusProcessing }
+func (c *ChargeVA) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeVA) ExpiresAt() time.Time         { return c.Response.ExpirationTime }
+func (c *ChargeVA) NextAction() NextAction {
+	return NextAction{Type: NextActionTransfer, AccountNumber: c.Response.AccountNumber}
+}
+
+func (c *ChargeBNPL) ChargeType() string           { return c.Type }
+func (c *ChargeBNPL) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeBNPL) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeBNPL) Status() string               { return chargeStatusProcessing }
+func (c *ChargeBNPL) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeBNPL) ExpiresAt() time.Time         { return time.Time{} }
+func (c *ChargeBNPL) NextAction() NextAction       { return redirectTo(c.Response.RedirectURL) }
+
+func (c *ChargeEwallet) ChargeType() string           { return c.Type }
+func (c *ChargeEwallet) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeEwallet) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeEwallet) Status() string               { return statusOrProcessing(c.Response.Status) }
+func (c *ChargeEwallet) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeEwallet) ExpiresAt() time.Time         { return c.Response.ExpirationTime }
+
+// NextAction returns redirect to CheckoutURL or WebURL, OVO has neither because the payment is approved in the app.
+func (c *ChargeEwallet) NextAction() NextAction {
+	if c.Response.CheckoutURL != "" {
+		return redirectTo(c.Response.CheckoutURL)
+	}
+
+	return redirectTo(c.Response.WebURL)
+}
+
+func (c *ChargeRetailStore) ChargeType() string           { return c.Type }
+func (c *ChargeRetailStore) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeRetailStore) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeRetailStore) Status() string               { return chargeStatusProcessing }
+func (c *ChargeRetailStore) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeRetailStore) ExpiresAt() time.Time         { return c.Response.ExpirationTime }
+func (c *ChargeRetailStore) NextAction() NextAction {
+	return NextAction{Type: NextActionTransfer, AccountNumber: c.Response.AccountNumber}
+}
+
+func (c *ChargeOnlineBank) ChargeType() string           { return c.Type }
+func (c *ChargeOnlineBank) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeOnlineBank) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeOnlineBank) Status() string               { return statusOrProcessing(c.Response.Status) }
+func (c *ChargeOnlineBank) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeOnlineBank) ExpiresAt() time.Time         { return c.Response.ExpirationTime }
+func (c *ChargeOnlineBank) NextAction() NextAction       { return redirectTo(c.Response.WebURL) }
+
+func (c *ChargeQRIS) ChargeType() string           { return c.Type }
+func (c *ChargeQRIS) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeQRIS) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeQRIS) Status() string               { return statusOrProcessing(c.Response.Status) }
+func (c *ChargeQRIS) PaidAmount() durianpay.Amount { return c.Response.Amount }
+func (c *ChargeQRIS) ExpiresAt() time.Time         { return c.Response.ExpirationTime }
+func (c *ChargeQRIS) NextAction() NextAction {
+	return NextAction{Type: NextActionScanQR, QRString: c.Response.QRString, QRCode: c.Response.QRCode}
+}
+
+func (c *ChargeCard) ChargeType() string           { return c.Type }
+func (c *ChargeCard) PaymentID() string            { return c.Response.PaymentID }
+func (c *ChargeCard) OrderID() string              { return c.Response.OrderID }
+func (c *ChargeCard) Status() string               { return statusOrProcessing(c.Response.Status) }
+func (c *ChargeCard) PaidAmount() durianpay.Amount { return c.Response.PaidAmount }
+func (c *ChargeCard) ExpiresAt() time.Time         { return time.Time{} }
+func (c *ChargeCard) NextAction() NextAction       { return redirectTo(c.Response.CheckoutURL) }
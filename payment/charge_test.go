@@ -0,0 +1,199 @@
+/*
+ * File Created: Monday, 19th October 2026 1:35:13 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/golang/mock/gomock"
+)
+
+func TestClient_Charge(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	sandbox := &durianpay.PaymentSandboxOption{ForceFail: true, DelayMS: 10}
+
+	tests := []struct {
+		name           string
+		req            durianpay.ChargeRequest
+		response       string
+		wantType       string
+		wantSandbox    *durianpay.PaymentSandboxOption
+		wantResType    ChargeResult
+		wantPaymentID  string
+		wantStatus     string
+		wantPaidAmount durianpay.Amount
+		wantExpiresAt  time.Time
+		wantNextAction NextAction
+	}{
+		{
+			name:           "VA",
+			req:            durianpay.PaymentChargeVAPayload{OrderID: "ord_VN5nVJpSW27112", BankCode: durianpay.BankMandiri, Name: "Name", Amount: durianpay.NewAmount(20000), SandboxOption: sandbox},
+			response:       "charge_va_200.json",
+			wantType:       durianpay.ChargeTypeVA,
+			wantSandbox:    sandbox,
+			wantResType:    &ChargeVA{},
+			wantPaymentID:  "pay_pYQ319c4qo5956",
+			wantStatus:     "processing",
+			wantExpiresAt:  tests.StringToTime("2023-09-05T10:00:00Z"),
+			wantNextAction: NextAction{Type: NextActionTransfer, AccountNumber: "7893572945724867"},
+		},
+		{
+			name:           "BNPL pointer",
+			req:            &durianpay.PaymentChargeBNPLPayload{OrderID: "ord_NDmLvwTTh95152", Amount: durianpay.NewAmount(80001), PaymentMethodUniqueID: "AKULAKU"},
+			response:       "charge_bnpl_200.json",
+			wantType:       durianpay.ChargeTypeBNPL,
+			wantResType:    &ChargeBNPL{},
+			wantPaymentID:  "pay_80pgxEcUbO8054",
+			wantStatus:     "processing",
+			wantPaidAmount: durianpay.NewAmount(80001),
+			wantNextAction: NextAction{Type: NextActionRedirect, URL: "https://redirect-url.com/"},
+		},
+		{
+			name:           "E-Wallet",
+			req:            durianpay.PaymentChargeEwalletPayload{OrderID: "ord_VN5nVJpSW27112", Amount: durianpay.NewAmount(10001), Mobile: "08123456789", WalletType: durianpay.WalletDANA},
+			response:       "charge_ewallet_200.json",
+			wantType:       durianpay.ChargeTypeEwallet,
+			wantResType:    &ChargeEwallet{},
+			wantPaymentID:  "pay_PoVnlDmGts4956",
+			wantStatus:     "processing",
+			wantPaidAmount: durianpay.NewAmount(10001),
+			wantNextAction: NextAction{Type: NextActionRedirect, URL: "https://checkout.durianpay.id/callback"},
+		},
+		{
+			name:           "Retail Store",
+			req:            durianpay.PaymentChargeRetailStorePayload{OrderID: "ord_VN5nVJpSW27112", BankCode: durianpay.RetailAlfamart, Name: "Name", Amount: durianpay.NewAmount(10001)},
+			response:       "charge_retailstore_200.json",
+			wantType:       durianpay.ChargeTypeRetailStore,
+			wantResType:    &ChargeRetailStore{},
+			wantPaymentID:  "pay_Ln1PZECuqf3748",
+			wantStatus:     "processing",
+			wantExpiresAt:  tests.StringToTime("2023-09-05T10:31:39.672938538Z"),
+			wantNextAction: NextAction{Type: NextActionTransfer, AccountNumber: "1111111111"},
+		},
+		{
+			name:           "Online Banking",
+			req:            durianpay.PaymentChargeOnlineBankingPayload{OrderID: "ord_VN5nVJpSW27112", Type: "JENIUSPAY", Amount: durianpay.NewAmount(10001)},
+			response:       "charge_onlinebanking_200.json",
+			wantType:       durianpay.ChargeTypeOnlineBanking,
+			wantResType:    &ChargeOnlineBank{},
+			wantPaymentID:  "pay_RGEkDpZZWR9662",
+			wantStatus:     "processing",
+			wantPaidAmount: durianpay.NewAmount(10001),
+			wantExpiresAt:  tests.StringToTime("2023-09-05T10:32:27.273180959Z"),
+			wantNextAction: NextAction{Type: NextActionNone},
+		},
+		{
+			name:           "QRIS",
+			req:            durianpay.PaymentChargeQRISPayload{OrderID: "ord_QETgbs2UGL3100", Type: "DANA", Amount: durianpay.NewAmount(80001)},
+			response:       "charge_qris_200.json",
+			wantType:       durianpay.ChargeTypeQRIS,
+			wantResType:    &ChargeQRIS{},
+			wantPaymentID:  "pay_s2sSBlDSWv4167",
+			wantStatus:     "processing",
+			wantPaidAmount: durianpay.NewAmount(80001),
+			wantExpiresAt:  tests.StringToTime("2021-09-15T15:44:37Z"),
+			wantNextAction: NextAction{Type: NextActionScanQR, QRString: "data:image/png;base64, long_qr_string"},
+		},
+		{
+			name:           "Card",
+			req:            durianpay.PaymentChargeCardPayload{OrderID: "ord_Gf7LimyjMk7270", Amount: durianpay.NewAmount(10001)},
+			response:       "charge_card_200.json",
+			wantType:       durianpay.ChargeTypeCard,
+			wantResType:    &ChargeCard{},
+			wantPaymentID:  "pay_TMlTVT3wvr3598",
+			wantStatus:     "completed",
+			wantPaidAmount: durianpay.NewAmount(10001),
+			wantNextAction: NextAction{Type: NextActionRedirect, URL: "https://link.to/card-checkout-url"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+			c := &Client{
+				ServerKey: featureWrap.ServerKey,
+				Api:       apiMock,
+			}
+
+			apiMock.EXPECT().
+				Req(gomock.Any(), "POST", pathCharge, nil, gomock.Any(), nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+					payload := body.(chargePayload)
+					if payload.Type != tt.wantType || !reflect.DeepEqual(payload.SandboxOption, tt.wantSandbox) {
+						t.Errorf("Client.Charge() payload = %+v, want type %s", payload, tt.wantType)
+					}
+
+					if _, ok := payload.Request.(durianpay.ChargeRequest); !ok || reflect.TypeOf(payload.Request).Kind() == reflect.Pointer {
+						t.Errorf("Client.Charge() request = %T, want payload value", payload.Request)
+					}
+
+					if err := json.Unmarshal(featureWrap.ResJSONByte(pathResponsePayment+tt.response), response); err != nil {
+						panic(err)
+					}
+
+					return nil
+				})
+
+			got, err := c.Charge(context.Background(), tt.req)
+			if err != nil {
+				t.Fatalf("Client.Charge() error = %v", err)
+			}
+
+			if reflect.TypeOf(got) != reflect.TypeOf(tt.wantResType) {
+				t.Errorf("Client.Charge() = %T, want %T", got, tt.wantResType)
+			}
+
+			if got.ChargeType() != tt.wantType || got.PaymentID() != tt.wantPaymentID || got.OrderID() == "" || got.Status() != tt.wantStatus {
+				t.Errorf("Client.Charge() = %s %s %s %s", got.ChargeType(), got.PaymentID(), got.OrderID(), got.Status())
+			}
+
+			if got.PaidAmount().Cmp(tt.wantPaidAmount) != 0 || !got.ExpiresAt().Equal(tt.wantExpiresAt) {
+				t.Errorf("Client.Charge() paid amount = %v, expires at = %v", got.PaidAmount(), got.ExpiresAt())
+			}
+
+			if gotNext := got.NextAction(); gotNext.Type != tt.wantNextAction.Type || gotNext.URL != tt.wantNextAction.URL ||
+				gotNext.AccountNumber != tt.wantNextAction.AccountNumber || gotNext.QRString != tt.wantNextAction.QRString {
+				t.Errorf("Client.Charge() next action = %+v, want %+v", gotNext, tt.wantNextAction)
+			}
+		})
+	}
+}
+
+func TestClient_Charge_Error(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	wantErr := &durianpay.Error{StatusCode: 500, ErrorCode: durianpay.ErrorCodeDPAYInternalError}
+	apiMock.EXPECT().
+		Req(gomock.Any(), "POST", pathCharge, nil, gomock.Any(), nil, gomock.Any()).
+		Return(wantErr)
+
+	// Result is nil interface, not nil *ChargeQRIS
+	got, err := c.Charge(context.Background(), durianpay.PaymentChargeQRISPayload{OrderID: "ord_1"})
+	if got != nil || err != wantErr {
+		t.Errorf("Client.Charge() = %v, %v, want nil & %v", got, err, wantErr)
+	}
+
+	var nilPayload *durianpay.PaymentChargeCardPayload
+	if got, err := c.Charge(context.Background(), nilPayload); got != nil || err == nil {
+		t.Errorf("Client.Charge() nil payload = %v, %v, want error", got, err)
+	}
+}
@@ -35,149 +35,49 @@ const (
 //
 //	[Doc Payment Charge API VA]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeVA(ctx context.Context, payload durianpay.PaymentChargeVAPayload) (*ChargeVA, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:          "VA",
-		Request:       payload,
-		SandboxOption: payload.SandboxOption,
-	}
-
-	res := struct {
-		Data ChargeVA `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeVA](ctx, c, payload)
 }
 
 // ChargeBNPL returns a response from Payment Charge API for Buy Now PayLater type
 //
 //	[Doc Payment Charge API BNPL]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeBNPL(ctx context.Context, payload durianpay.PaymentChargeBNPLPayload) (*ChargeBNPL, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:          "BNPL",
-		Request:       payload,
-		SandboxOption: payload.SandboxOption,
-	}
-
-	res := struct {
-		Data ChargeBNPL `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeBNPL](ctx, c, payload)
 }
 
 // ChargeEwallet returns a response from Payment Charge API for E-Wallet type
 //
 //	[Doc Payment Charge API E-Wallet]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeEwallet(ctx context.Context, payload durianpay.PaymentChargeEwalletPayload) (*ChargeEwallet, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:    "EWALLET",
-		Request: payload,
-	}
-
-	res := struct {
-		Data ChargeEwallet `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeEwallet](ctx, c, payload)
 }
 
 // ChargeRetailStore returns a response from Payment Charge API for Retail Store type (ex: Indomaret / Alfamaret)
 //
 //	[Doc Payment Charge API Retail Store]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeRetailStore(ctx context.Context, payload durianpay.PaymentChargeRetailStorePayload) (*ChargeRetailStore, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:    "RETAILSTORE",
-		Request: payload,
-	}
-
-	res := struct {
-		Data ChargeRetailStore `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeRetailStore](ctx, c, payload)
 }
 
 // ChargeOnlineBank returns a response from Payment Charge API for Online Banking type (ex: JeniusPay)
 //
 //	[Doc Payment Charge API Online Bank]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeOnlineBank(ctx context.Context, payload durianpay.PaymentChargeOnlineBankingPayload) (*ChargeOnlineBank, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:    "ONLINE_BANKING",
-		Request: payload,
-	}
-
-	res := struct {
-		Data ChargeOnlineBank `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeOnlineBank](ctx, c, payload)
 }
 
 // ChargeQRIS returns a response from Payment Charge API for QRIS type
 //
 //	[Doc Payment Charge API Online Bank]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeQRIS(ctx context.Context, payload durianpay.PaymentChargeQRISPayload) (*ChargeQRIS, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:    "QRIS",
-		Request: payload,
-	}
-
-	res := struct {
-		Data ChargeQRIS `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeQRIS](ctx, c, payload)
 }
 
 // ChargeCard returns a response from Payment Charge API for CARD type
 //
 //	[Doc Payment Charge API Online Bank]: https://durianpay.id/docs/api/payments/charge/
 func (c *Client) ChargeCard(ctx context.Context, payload durianpay.PaymentChargeCardPayload) (*ChargeCard, *durianpay.Error) {
-	reqPayload := chargePayload{
-		Type:    "CARD",
-		Request: payload,
-	}
-
-	res := struct {
-		Data ChargeCard `json:"data"`
-	}{}
-
-	err := c.Api.Req(ctx, http.MethodPost, pathCharge, nil, reqPayload, nil, &res)
-	if err != nil {
-		return nil, err
-	}
-
-	return &res.Data, nil
+	return charge[ChargeCard](ctx, c, payload)
 }
 
 // FetchPayments returns a response from Payment Fetch API
@@ -188,7 +88,7 @@ func (c *Client) FetchPayments(ctx context.Context, opt durianpay.PaymentFetchOp
 		Data FetchPayments `json:"data"`
 	}{}
 
-	err := c.Api.Req(ctx, http.MethodGet, pathCharge, opt, nil, nil, &res)
+	err := c.Api.Req(ctx, http.MethodGet, pathPayment, opt, nil, nil, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -196,6 +96,42 @@ func (c *Client) FetchPayments(ctx context.Context, opt durianpay.PaymentFetchOp
 	return &res.Data, nil
 }
 
+// IteratePayments returns iterator over every payment of Payment Fetch API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IteratePayments(ctx context.Context, opt durianpay.PaymentFetchOption) *common.Iterator[Payments] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[Payments] {
+		opt.Range = window
+		return c.paymentPages(opt)
+	})
+}
+
+// BulkPayments returns fetcher of every payment of Payment Fetch API, pages after the first are fetched concurrently.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) BulkPayments(opt durianpay.PaymentFetchOption) *common.BulkFetcher[Payments] {
+	return &common.BulkFetcher[Payments]{Fetch: c.paymentPages(opt), Skip: int(opt.Skip), Limit: int(opt.Limit)}
+}
+
+// paymentPages returns page fetcher of FetchPayments for the given filters of opt.
+func (c *Client) paymentPages(opt durianpay.PaymentFetchOption) common.PageFetcher[Payments] {
+	return func(ctx context.Context, skip, limit int) (common.Page[Payments], *durianpay.Error) {
+		// Copy of opt, pages may be fetched concurrently by common.BulkFetcher
+		opt := opt
+
+		var err *durianpay.Error
+		if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+			return common.Page[Payments]{}, err
+		}
+
+		res, err := c.FetchPayments(ctx, opt)
+		if err != nil {
+			return common.Page[Payments]{}, err
+		}
+
+		return common.Page[Payments]{Items: res.Payments, Total: int(res.Total)}, nil
+	}
+}
+
 // FetchPaymentByID returns a response from Payment Fetch by ID API.
 //
 //	[Doc Payment Fetch by ID API]: https://durianpay.id/docs/api/payments/fetch-one/
@@ -12,7 +12,6 @@ import (
 	"reflect"
 	"strings"
 	"testing"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 	"github.com/abmid/dpay-sdk-go/internal/tests"
@@ -60,7 +59,7 @@ func TestClient_ChargeVA(t *testing.T) {
 					OrderID:      "ord_WkJWY1ysZ57194",
 					BankCode:     "MANDIRI",
 					Name:         "Name Appear in ATM",
-					Amount:       "20000",
+					Amount:       durianpay.NewAmount(20000),
 					PaymentRefID: "pay_ref_123",
 				},
 			},
@@ -84,66 +83,40 @@ func TestClient_ChargeVA(t *testing.T) {
 			},
 			wantRes: &ChargeVA{
 				Type: "VA",
-				Response: chargeResponseVA{
-					PaymentID:      "pay_pYQ319c4qo5956",
-					OrderID:        "ord_VN5nVJpSW27112",
+				Response: ChargeResponseVA{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_pYQ319c4qo5956", OrderID: "ord_VN5nVJpSW27112"},
 					AccountNumber:  "7893572945724867",
 					PaymentRefID:   "pay_ref_123",
 					ExpirationTime: tests.StringToTime("2023-09-05T10:00:00Z"),
-					PaymentInstruction: struct {
-						EN paymentInstruction "json:\"en\""
-						ID paymentInstruction "json:\"ID\""
-					}{
-						EN: paymentInstruction{
-							Atm: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+					PaymentInstruction: PaymentInstructions{
+						EN: PaymentInstruction{
+							Atm: InstructionStep{
 								Heading:         "ATM Mandiri",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
-							MobileApp: struct {
-								Heading         string "json:\"heading\""
-								AppStoreURL     string "json:\"appstore_url\""
-								PlayStoreURL    string "json:\"playstore_url\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							MobileApp: MobileAppInstruction{
 								Heading:         "Mandiri Online",
 								AppStoreURL:     "<<http://onelink.to/dvs8pn",
 								PlayStoreURL:    "http://onelink.to/dvs8pn",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
-							InternetBanking: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							InternetBanking: InstructionStep{
 								Heading:         "Internet Banking",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
 						},
-						ID: paymentInstruction{
-							Atm: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+						ID: PaymentInstruction{
+							Atm: InstructionStep{
 								Heading:         "ATM Mandiri",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
-							MobileApp: struct {
-								Heading         string "json:\"heading\""
-								AppStoreURL     string "json:\"appstore_url\""
-								PlayStoreURL    string "json:\"playstore_url\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							MobileApp: MobileAppInstruction{
 								Heading:         "Mandiri Online",
 								AppStoreURL:     "<<http://onelink.to/dvs8pn",
 								PlayStoreURL:    "http://onelink.to/dvs8pn",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
-							InternetBanking: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							InternetBanking: InstructionStep{
 								Heading:         "Internet Banking",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
@@ -160,7 +133,7 @@ func TestClient_ChargeVA(t *testing.T) {
 					OrderID:      "ord_WkJWY1ysZ57194",
 					BankCode:     "MANDIRI",
 					Name:         "Name Appear in ATM",
-					Amount:       "20000",
+					Amount:       durianpay.NewAmount(20000),
 					PaymentRefID: "pay_ref_123",
 					SandboxOption: &durianpay.PaymentSandboxOption{
 						ForceFail: true,
@@ -188,66 +161,40 @@ func TestClient_ChargeVA(t *testing.T) {
 			},
 			wantRes: &ChargeVA{
 				Type: "VA",
-				Response: chargeResponseVA{
-					PaymentID:      "pay_pYQ319c4qo5956",
-					OrderID:        "ord_VN5nVJpSW27112",
+				Response: ChargeResponseVA{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_pYQ319c4qo5956", OrderID: "ord_VN5nVJpSW27112"},
 					AccountNumber:  "7893572945724867",
 					PaymentRefID:   "pay_ref_123",
 					ExpirationTime: tests.StringToTime("2023-09-05T10:00:00Z"),
-					PaymentInstruction: struct {
-						EN paymentInstruction "json:\"en\""
-						ID paymentInstruction "json:\"ID\""
-					}{
-						EN: paymentInstruction{
-							Atm: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+					PaymentInstruction: PaymentInstructions{
+						EN: PaymentInstruction{
+							Atm: InstructionStep{
 								Heading:         "ATM Mandiri",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
-							MobileApp: struct {
-								Heading         string "json:\"heading\""
-								AppStoreURL     string "json:\"appstore_url\""
-								PlayStoreURL    string "json:\"playstore_url\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							MobileApp: MobileAppInstruction{
 								Heading:         "Mandiri Online",
 								AppStoreURL:     "<<http://onelink.to/dvs8pn",
 								PlayStoreURL:    "http://onelink.to/dvs8pn",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
-							InternetBanking: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							InternetBanking: InstructionStep{
 								Heading:         "Internet Banking",
 								InstructionText: "<ol><li>Insert your ATM card and select \"ENGLISH\"</li></ol>",
 							},
 						},
-						ID: paymentInstruction{
-							Atm: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+						ID: PaymentInstruction{
+							Atm: InstructionStep{
 								Heading:         "ATM Mandiri",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
-							MobileApp: struct {
-								Heading         string "json:\"heading\""
-								AppStoreURL     string "json:\"appstore_url\""
-								PlayStoreURL    string "json:\"playstore_url\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							MobileApp: MobileAppInstruction{
 								Heading:         "Mandiri Online",
 								AppStoreURL:     "<<http://onelink.to/dvs8pn",
 								PlayStoreURL:    "http://onelink.to/dvs8pn",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
-							InternetBanking: struct {
-								Heading         string "json:\"heading\""
-								InstructionText string "json:\"instruction_text\""
-							}{
+							InternetBanking: InstructionStep{
 								Heading:         "Internet Banking",
 								InstructionText: "<ol><li>Masukkan kartu ATM dan pilih \"Bahasa Indonesia\"</li></ol>",
 							},
@@ -350,7 +297,7 @@ func TestClient_ChargeBNPL(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.PaymentChargeBNPLPayload{
 					OrderID:               "ord_1EcWGI2xSs7216",
-					Amount:                "10000.00",
+					Amount:                durianpay.MustParseAmount("10000.00"),
 					PaymentRefID:          "pay_ref_123",
 					PaymentMethodUniqueID: "AKULAKU",
 					CustomerInfo: durianpay.PaymentCustomerInfo{
@@ -380,12 +327,11 @@ func TestClient_ChargeBNPL(t *testing.T) {
 			},
 			wantRes: &ChargeBNPL{
 				Type: "BNPL",
-				Response: chargeResponseBNPL{
-					PaymentID:    "pay_80pgxEcUbO8054",
-					OrderID:      "ord_NDmLvwTTh95152",
+				Response: ChargeResponseBNPL{
+					ChargeCommon: ChargeCommon{PaymentID: "pay_80pgxEcUbO8054", OrderID: "ord_NDmLvwTTh95152"},
 					PaymentRefID: "pay_ref_123",
 					RedirectURL:  "https://redirect-url.com/",
-					PaidAmount:   "80001.00",
+					PaidAmount:   durianpay.MustParseAmount("80001.00"),
 					Metadata:     map[string]string{},
 				},
 			},
@@ -467,7 +413,7 @@ func TestClient_ChargeEwallet(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.PaymentChargeEwalletPayload{
 					OrderID:    "ord_mJH2hKOSYb3514",
-					Amount:     "20000.00",
+					Amount:     durianpay.MustParseAmount("20000.00"),
 					Mobile:     "08123456789",
 					WalletType: "DANA",
 				},
@@ -492,14 +438,13 @@ func TestClient_ChargeEwallet(t *testing.T) {
 			},
 			wantRes: &ChargeEwallet{
 				Type: "EWALLET",
-				Response: chargeResponseEwallet{
-					PaymentID:      "pay_PoVnlDmGts4956",
-					OrderID:        "ord_VN5nVJpSW27112",
+				Response: ChargeResponseEwallet{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_PoVnlDmGts4956", OrderID: "ord_VN5nVJpSW27112"},
 					Mobile:         "08123456789",
 					Status:         "processing",
 					ExpirationTime: tests.StringToTime("0001-01-01T00:00:00Z"),
 					CheckoutURL:    "https://checkout.durianpay.id/callback",
-					PaidAmount:     "10001.00",
+					PaidAmount:     durianpay.MustParseAmount("10001.00"),
 				},
 			},
 		},
@@ -580,7 +525,7 @@ func TestClient_ChargeRetailStore(t *testing.T) {
 					OrderID:      "ord_mJH2hKOSYb3514",
 					BankCode:     "ALFAMART",
 					Name:         "Name Appear in ATM",
-					Amount:       "20000.00",
+					Amount:       durianpay.MustParseAmount("20000.00"),
 					PaymentRefID: "pay_ref_123",
 				},
 			},
@@ -604,9 +549,8 @@ func TestClient_ChargeRetailStore(t *testing.T) {
 			},
 			wantRes: &ChargeRetailStore{
 				Type: "RETAILSTORE",
-				Response: chargeResponseRetailStore{
-					PaymentID:      "pay_Ln1PZECuqf3748",
-					OrderID:        "ord_VN5nVJpSW27112",
+				Response: ChargeResponseRetailStore{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_Ln1PZECuqf3748", OrderID: "ord_VN5nVJpSW27112"},
 					AccountNumber:  "1111111111",
 					PaymentRefID:   "pay_ref_123",
 					ExpirationTime: tests.StringToTime("2023-09-05T10:31:39.672938538Z"),
@@ -690,7 +634,7 @@ func TestClient_ChargeOnlineBank(t *testing.T) {
 					OrderID: "ord_mJH2hKOSYb3514",
 					Type:    "JENIUSPAY",
 					Name:    "Name Appear in ATM",
-					Amount:  "20000.00",
+					Amount:  durianpay.MustParseAmount("20000.00"),
 					CustomerInfo: durianpay.PaymentCustomerInfo{
 						Email:     "jude_kasper@koss.in",
 						GivenName: "Jude Kasper",
@@ -718,13 +662,12 @@ func TestClient_ChargeOnlineBank(t *testing.T) {
 			},
 			wantRes: &ChargeOnlineBank{
 				Type: "ONLINE_BANKING",
-				Response: chargeResponseOnlineBank{
-					PaymentID:      "pay_RGEkDpZZWR9662",
-					OrderID:        "ord_VN5nVJpSW27112",
+				Response: ChargeResponseOnlineBank{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_RGEkDpZZWR9662", OrderID: "ord_VN5nVJpSW27112"},
 					Mobile:         "+6285722173217",
 					Status:         "processing",
 					ExpirationTime: tests.StringToTime("2023-09-05T10:32:27.273180959Z"),
-					PaidAmount:     "10001.00",
+					PaidAmount:     durianpay.MustParseAmount("10001.00"),
 				},
 			},
 		},
@@ -804,7 +747,7 @@ func TestClient_ChargeQRIS(t *testing.T) {
 				payload: durianpay.PaymentChargeQRISPayload{
 					OrderID: "ord_ZSHipeBgUd4740",
 					Type:    "DANA",
-					Amount:  "80001.00",
+					Amount:  durianpay.MustParseAmount("80001.00"),
 					Name:    "Name Appear in ATM",
 				},
 			},
@@ -827,9 +770,8 @@ func TestClient_ChargeQRIS(t *testing.T) {
 			},
 			wantRes: &ChargeQRIS{
 				Type: "QRIS",
-				Response: chargeResponseQRIS{
-					PaymentID:      "pay_s2sSBlDSWv4167",
-					OrderID:        "ord_QETgbs2UGL3100",
+				Response: ChargeResponseQRIS{
+					ChargeCommon:   ChargeCommon{PaymentID: "pay_s2sSBlDSWv4167", OrderID: "ord_QETgbs2UGL3100"},
 					Status:         "processing",
 					ExpirationTime: tests.StringToTime("2021-09-15T15:44:37Z"),
 					CreationTime:   tests.StringToTime("2021-09-12T15:44:37Z"),
@@ -839,7 +781,7 @@ func TestClient_ChargeQRIS(t *testing.T) {
 						"merchant_name": "Durianpay",
 						"merchant_id":   "sample_national_merchant_id",
 					},
-					Amount: "80001.00",
+					Amount: durianpay.MustParseAmount("80001.00"),
 					QRCode: "00020101021226590013ID.CO.BNI.WWW011893600009150002286002092107061320303UME51470015ID.OR.GPNQR.WWW0217ID2107271315771960303UME520454995303360540880001.005802ID5905Ajesh6013JAKARTA PUSAT6105101406214011038291492856304E1F",
 				},
 			},
@@ -920,7 +862,7 @@ func TestClient_ChargeCard(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.PaymentChargeCardPayload{
 					OrderID:      "ord_1EcWGI2xSs7216",
-					Amount:       "10000.00",
+					Amount:       durianpay.MustParseAmount("10000.00"),
 					PaymentRefID: "pay_ref_123",
 					CustomerInfo: durianpay.PaymentCustomerInfo{
 						ID:        "cus_aGn5UD0m7F0994",
@@ -948,12 +890,11 @@ func TestClient_ChargeCard(t *testing.T) {
 			},
 			wantRes: &ChargeCard{
 				Type: "CARD",
-				Response: chargeResponseCard{
-					PaymentID:    "pay_TMlTVT3wvr3598",
-					OrderID:      "ord_Gf7LimyjMk7270",
+				Response: ChargeResponseCard{
+					ChargeCommon: ChargeCommon{PaymentID: "pay_TMlTVT3wvr3598", OrderID: "ord_Gf7LimyjMk7270"},
 					PaymentRefID: "pay_ref_123",
 					Status:       "completed",
-					PaidAmount:   "10001.00",
+					PaidAmount:   durianpay.MustParseAmount("10001.00"),
 					CheckoutURL:  "https://link.to/card-checkout-url",
 					Metadata:     make(map[string]string),
 				},
@@ -1028,13 +969,12 @@ func TestClient_FetchPayments(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.PaymentFetchOption{
-					From: time.Now().Format("2006-01-02"),
-					To:   time.Now().Format("2006-01-02"),
+					Range: durianpay.Today(),
 				},
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
-					Req(gomock.Any(), "GET", pathCharge, args.opt, nil, nil, gomock.Any()).
+					Req(gomock.Any(), "GET", pathPayment, args.opt, nil, nil, gomock.Any()).
 					DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
 						err := json.Unmarshal(featureWrap.ResJSONByte(pathResponsePayment+"fetch_payments_200.json"), response)
 						if err != nil {
@@ -1050,7 +990,7 @@ func TestClient_FetchPayments(t *testing.T) {
 						ID:                 "pay_80pgxEcUbO8054",
 						OrderID:            "ord_NDmLvwTTh95152",
 						PaymentRefID:       "pay_ref_123",
-						Amount:             "10001.00",
+						Amount:             durianpay.MustParseAmount("10001.00"),
 						Status:             "processing",
 						IsLive:             false,
 						ExpirationDate:     tests.StringToTime("0001-01-01T00:00:00Z"),
@@ -1078,7 +1018,7 @@ func TestClient_FetchPayments(t *testing.T) {
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
-					Req(gomock.Any(), "GET", pathCharge, args.opt, nil, nil, gomock.Any()).
+					Req(gomock.Any(), "GET", pathPayment, args.opt, nil, nil, gomock.Any()).
 					Return(durianpay.FromAPI(500, featureWrap.ResJSONByte(pathResponse+"internal_server_error_500.json")))
 			},
 			wantErr: durianpay.FromAPI(500, featureWrap.ResJSONByte(pathResponse+"internal_server_error_500.json")),
@@ -1149,7 +1089,7 @@ func TestClient_FetchPaymentByID(t *testing.T) {
 				OrderID:            "ord_VN5nVJpSW27112",
 				MerchantID:         "mer_pHXgBZ2Qx95625",
 				PaymentRefID:       "pay_ref_123",
-				Amount:             "10001.00",
+				Amount:             durianpay.MustParseAmount("10001.00"),
 				Status:             "processing",
 				IsLive:             false,
 				ExpirationDate:     tests.StringToTime("2023-09-05T10:31:39.672939Z"),
@@ -1188,7 +1128,7 @@ func TestClient_FetchPaymentByID(t *testing.T) {
 				OrderID:            "ord_VN5nVJpSW27112",
 				MerchantID:         "mer_pHXgBZ2Qx95625",
 				PaymentRefID:       "pay_ref_123",
-				Amount:             "10001.00",
+				Amount:             durianpay.MustParseAmount("10001.00"),
 				Status:             "cancelled",
 				IsLive:             false,
 				ExpirationDate:     tests.StringToTime("2023-09-05T10:31:39.672939Z"),
@@ -1234,7 +1174,7 @@ func TestClient_FetchPaymentByID(t *testing.T) {
 				OrderID:            "ord_VN5nVJpSW27112",
 				MerchantID:         "mer_pHXgBZ2Qx95625",
 				PaymentRefID:       "pay_ref_123",
-				Amount:             "10001.00",
+				Amount:             durianpay.MustParseAmount("10001.00"),
 				Status:             "cancelled",
 				IsLive:             false,
 				ExpirationDate:     tests.StringToTime("2023-09-05T10:31:39.672939Z"),
@@ -1248,7 +1188,7 @@ func TestClient_FetchPaymentByID(t *testing.T) {
 					MerchantID: "mer_pHXgBZ2Qx95625",
 					CustomerID: "cus_L6WNLJrTKT6000",
 					OrderRefID: "order_ref_002",
-					Amount:     "10001.00",
+					Amount:     durianpay.MustParseAmount("10001.00"),
 					Currency:   "IDR",
 					Status:     "completed",
 					IsLive:     false,
@@ -1475,7 +1415,7 @@ func TestClient_Capture(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				payload: durianpay.PaymentCapturePayload{
-					Amount: "1000.00",
+					Amount: durianpay.MustParseAmount("1000.00"),
 				},
 				ID: "pay_wA2X2Mvm2d4965",
 			},
@@ -1496,8 +1436,8 @@ func TestClient_Capture(t *testing.T) {
 			wantRes: &Capture{
 				PaymentID:           "pay_123",
 				OrderID:             "ord_123",
-				PreauthorizedAmount: "1000.00",
-				PaidAmount:          "1000.00",
+				PreauthorizedAmount: durianpay.MustParseAmount("1000.00"),
+				PaidAmount:          durianpay.MustParseAmount("1000.00"),
 				Status:              "processing",
 				CreatedAt:           tests.StringToTime("2022-12-15T10:51:47.829636Z"),
 				UpdatedAt:           tests.StringToTime("2022-12-15T16:26:25.076181Z"),
@@ -1646,7 +1586,7 @@ func TestClient_MDRFeesCalculation(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.PaymentMDRFeesOption{
-					Amount:        "500000",
+					Amount:        durianpay.NewAmount(500000),
 					PaymentMethod: "all",
 				},
 			},
@@ -1664,14 +1604,14 @@ func TestClient_MDRFeesCalculation(t *testing.T) {
 			},
 			wantRes: &MDRFeesCalculation{
 				OVO: MDRFee{
-					ActualAmount: 492500,
-					Fees:         7500,
-					TotalAmount:  500000,
+					ActualAmount: durianpay.NewAmount(492500),
+					Fees:         durianpay.NewAmount(7500),
+					TotalAmount:  durianpay.NewAmount(500000),
 				},
 				SHOPEEPAY: MDRFee{
-					ActualAmount: 492500,
-					Fees:         7500,
-					TotalAmount:  500000,
+					ActualAmount: durianpay.NewAmount(492500),
+					Fees:         durianpay.NewAmount(7500),
+					TotalAmount:  durianpay.NewAmount(500000),
 				},
 			},
 		},
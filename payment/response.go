@@ -6,150 +6,160 @@
  */
 package payment
 
-import "time"
+import (
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
 
 // ChargeVA use for response Payment Charge API (VA)
 type ChargeVA struct {
 	Type     string           `json:"type"`
-	Response chargeResponseVA `json:""response`
+	Response ChargeResponseVA `json:"response"`
 }
 
 // ChargeBNPL use for response Payment Charge API (Buy Now PayLater)
 type ChargeBNPL struct {
 	Type     string             `json:"type"`
-	Response chargeResponseBNPL `type:"response"`
+	Response ChargeResponseBNPL `json:"response"`
 }
 
 // ChargeEwallet use for response Payment Charge API (E-Wallet)
 type ChargeEwallet struct {
 	Type     string                `json:"type"`
-	Response chargeResponseEwallet `json:"response"`
+	Response ChargeResponseEwallet `json:"response"`
 }
 
 // ChargeRetailStore use for response Payment Charge API (Retail Store)
 type ChargeRetailStore struct {
 	Type     string                    `json:"type"`
-	Response chargeResponseRetailStore `json:"response"`
+	Response ChargeResponseRetailStore `json:"response"`
 }
 
 // ChargeOnlineBank use for response Payment Charge API (Online Bank)
 type ChargeOnlineBank struct {
 	Type     string                   `json:"type"`
-	Response chargeResponseOnlineBank `json:"response"`
+	Response ChargeResponseOnlineBank `json:"response"`
 }
 
 // ChargeQRIS use for response Payment Charge API (QRIS)
 type ChargeQRIS struct {
 	Type     string             `json:"type"`
-	Response chargeResponseQRIS `json:"response"`
+	Response ChargeResponseQRIS `json:"response"`
 }
 
 // ChargeCard use for response Payment Charge API (Card)
 type ChargeCard struct {
 	Type     string             `json:"type"`
-	Response chargeResponseCard `json:"response"`
+	Response ChargeResponseCard `json:"response"`
+}
+
+// ChargeCommon is fields of response common to every payment method, it is embedded in ChargeResponseVA, ChargeResponseEwallet, etc.
+// Use ChargeResult to read them from the charge of any payment method.
+type ChargeCommon struct {
+	PaymentID string `json:"payment_id"`
+	OrderID   string `json:"order_id"`
 }
 
 // ChargeResponseVA represents response for Payment Charge use Virtual Account
-type chargeResponseVA struct {
-	PaymentID          string    `json:"payment_id"`
-	OrderID            string    `json:"order_id"`
-	AccountNumber      string    `json:"account_number"`
-	PaymentRefID       string    `json:"payment_ref_id"`
-	ExpirationTime     time.Time `json:"expiration_time"`
-	PaidAmount         string    `json:"paid_amount"`
-	PaymentInstruction struct {
-		EN paymentInstruction `json:"en"`
-		ID paymentInstruction `json:"ID"`
-	} `json:"payment_instruction"`
+type ChargeResponseVA struct {
+	ChargeCommon
+	AccountNumber      string              `json:"account_number"`
+	PaymentRefID       string              `json:"payment_ref_id"`
+	ExpirationTime     time.Time           `json:"expiration_time"`
+	PaidAmount         durianpay.Amount    `json:"paid_amount"`
+	PaymentInstruction PaymentInstructions `json:"payment_instruction"`
+}
+
+// PaymentInstructions is part of ChargeResponseVA for attribute PaymentInstruction, in English & Indonesian
+type PaymentInstructions struct {
+	EN PaymentInstruction `json:"en"`
+	ID PaymentInstruction `json:"ID"`
+}
+
+// PaymentInstruction is instruction to pay Virtual Account of a language
+type PaymentInstruction struct {
+	Atm             InstructionStep      `json:"atm"`
+	MobileApp       MobileAppInstruction `json:"mobile_app"`
+	InternetBanking InstructionStep      `json:"internet_banking"`
+}
+
+// InstructionStep is part of PaymentInstruction for a channel, InstructionText is HTML
+type InstructionStep struct {
+	Heading         string `json:"heading"`
+	InstructionText string `json:"instruction_text"`
 }
 
-// paymentInstruction is part of ChargeResponseVA for attribute PaymentInstruction
-type paymentInstruction struct {
-	Atm struct {
-		Heading         string `json:"heading"`
-		InstructionText string `json:"instruction_text"`
-	} `json:"atm"`
-	MobileApp struct {
-		Heading         string `json:"heading"`
-		AppStoreURL     string `json:"appstore_url"`
-		PlayStoreURL    string `json:"playstore_url"`
-		InstructionText string `json:"instruction_text"`
-	} `json:"mobile_app"`
-	InternetBanking struct {
-		Heading         string `json:"heading"`
-		InstructionText string `json:"instruction_text"`
-	} `json:"internet_banking"`
+// MobileAppInstruction is part of PaymentInstruction for attribute MobileApp
+type MobileAppInstruction struct {
+	Heading         string `json:"heading"`
+	AppStoreURL     string `json:"appstore_url"`
+	PlayStoreURL    string `json:"playstore_url"`
+	InstructionText string `json:"instruction_text"`
 }
 
-// chargeResponseEwallet represents response for Payment Charge use E-Wallet
-type chargeResponseEwallet struct {
-	PaymentID      string    `json:"payment_id"`
-	OrderID        string    `json:"order_id"`
-	Mobile         string    `json:"mobile"`
-	Status         string    `json:"status"`
-	ExpirationTime time.Time `json:"expiration_time"`
-	CheckoutURL    string    `json:"checkout_url"`
-	WebURL         string    `json:"web_url"`
-	UniqueID       string    `json:"unique_id"`
-	PaidAmount     string    `json:"paid_amount"`
+// ChargeResponseEwallet represents response for Payment Charge use E-Wallet
+type ChargeResponseEwallet struct {
+	ChargeCommon
+	Mobile         string           `json:"mobile"`
+	Status         string           `json:"status"`
+	ExpirationTime time.Time        `json:"expiration_time"`
+	CheckoutURL    string           `json:"checkout_url"`
+	WebURL         string           `json:"web_url"`
+	UniqueID       string           `json:"unique_id"`
+	PaidAmount     durianpay.Amount `json:"paid_amount"`
 }
 
-// chargeResponseRetailStore represents response for Payment Charge use Retail Store (Alfamart, Indomaret)
-type chargeResponseRetailStore struct {
-	PaymentID      string    `json:"payment_id"`
-	OrderID        string    `json:"order_id"`
-	AccountNumber  string    `json:"account_number"`
-	PaymentRefID   string    `json:"payment_ref_id"`
-	ExpirationTime time.Time `json:"expiration_time"`
-	PaidAmount     string    `json:"paid_amount"`
+// ChargeResponseRetailStore represents response for Payment Charge use Retail Store (Alfamart, Indomaret)
+type ChargeResponseRetailStore struct {
+	ChargeCommon
+	AccountNumber  string           `json:"account_number"`
+	PaymentRefID   string           `json:"payment_ref_id"`
+	ExpirationTime time.Time        `json:"expiration_time"`
+	PaidAmount     durianpay.Amount `json:"paid_amount"`
 }
 
-// chargeResponseOnlineBank represents response for payment charge use Online Bank like JeniusPay.
-type chargeResponseOnlineBank struct {
-	PaymentID      string    `json:"payment_id"`
-	OrderID        string    `json:"order_id"`
-	Mobile         string    `json:"mobile"`
-	Status         string    `json:"status"`
-	ExpirationTime time.Time `json:"expiration_time"`
-	WebURL         string    `json:"web_url"`
-	UniqueID       string    `json:"unique_id"`
-	PaidAmount     string    `json:"paid_amount"`
+// ChargeResponseOnlineBank represents response for payment charge use Online Bank like JeniusPay.
+type ChargeResponseOnlineBank struct {
+	ChargeCommon
+	Mobile         string           `json:"mobile"`
+	Status         string           `json:"status"`
+	ExpirationTime time.Time        `json:"expiration_time"`
+	WebURL         string           `json:"web_url"`
+	UniqueID       string           `json:"unique_id"`
+	PaidAmount     durianpay.Amount `json:"paid_amount"`
 }
 
-// chargeResponseQRIS represents response for payment char use QRIS
-type chargeResponseQRIS struct {
-	PaymentID      string            `json:"payment_id"`
-	OrderID        string            `json:"order_id"`
+// ChargeResponseQRIS represents response for payment charge use QRIS
+type ChargeResponseQRIS struct {
+	ChargeCommon
 	Status         string            `json:"status"`
 	ExpirationTime time.Time         `json:"expiration_time"`
 	CreationTime   time.Time         `json:"creation_time"`
 	QRString       string            `json:"qr_string"`
 	UniqueID       string            `json:"unique_id"`
 	Metadata       map[string]string `json:"metadata"`
-	Amount         string            `json:"amount"`
+	Amount         durianpay.Amount  `json:"amount"`
 	QRCode         string            `json:"qr_code"`
 }
 
-// chargeResponseCard represents response for payment charge use card
-type chargeResponseCard struct {
-	PaymentID    string            `json:"payment_id"`
-	OrderID      string            `json:"order_id"`
+// ChargeResponseCard represents response for payment charge use card
+type ChargeResponseCard struct {
+	ChargeCommon
 	PaymentRefID string            `json:"payment_ref_id"`
 	TokenID      string            `json:"token_id"`
 	Status       string            `json:"status"`
-	PaidAmount   string            `json:"paid_amount"`
+	PaidAmount   durianpay.Amount  `json:"paid_amount"`
 	Metadata     map[string]string `json:"metadata"`
 	CheckoutURL  string            `json:"checkout_url"`
 }
 
-// chargeResponseBNPL represents response for payment charge use Buy Now PayLater
-type chargeResponseBNPL struct {
-	PaymentID    string            `json:"payment_id"`
-	OrderID      string            `json:"order_id"`
+// ChargeResponseBNPL represents response for payment charge use Buy Now PayLater
+type ChargeResponseBNPL struct {
+	ChargeCommon
 	PaymentRefID string            `json:"payment_ref_id"`
 	RedirectURL  string            `json:"redirect_url"`
-	PaidAmount   string            `json:"paid_amount"`
+	PaidAmount   durianpay.Amount  `json:"paid_amount"`
 	Metadata     map[string]string `json:"metadata"`
 }
 
@@ -173,16 +183,16 @@ type PaymentCustomer struct {
 
 // PaymentOrder is part of Payment for attribute Order
 type PaymentOrder struct {
-	ID           string    `json:"id"`
-	MerchantID   string    `json:"merchant_id"`
-	CustomerID   string    `json:"customer_id"`
-	OrderRefID   string    `json:"order_ref_id"`
-	OrderDsRefID string    `json:"order_ds_ref_id"`
-	Amount       string    `json:"amount"`
-	Currency     string    `json:"currency"`
-	Status       string    `json:"status"`
-	IsLive       bool      `json:"is_live"`
-	CreatedAt    time.Time `json:"created_at"`
+	ID           string           `json:"id"`
+	MerchantID   string           `json:"merchant_id"`
+	CustomerID   string           `json:"customer_id"`
+	OrderRefID   string           `json:"order_ref_id"`
+	OrderDsRefID string           `json:"order_ds_ref_id"`
+	Amount       durianpay.Amount `json:"amount"`
+	Currency     string           `json:"currency"`
+	Status       string           `json:"status"`
+	IsLive       bool             `json:"is_live"`
+	CreatedAt    time.Time        `json:"created_at"`
 }
 
 // Payments is part of FetchPayments for attribute payments.
@@ -192,7 +202,7 @@ type Payments struct {
 	PaymentRefID       string            `json:"payment_ref_id"`
 	SettlementID       string            `json:"settlement_id"`
 	PaymentDsRefID     string            `json:"payment_ds_ref_id"`
-	Amount             string            `json:"amount"`
+	Amount             durianpay.Amount  `json:"amount"`
 	Status             string            `json:"status"`
 	IsLive             bool              `json:"is_live"`
 	ExpirationDate     time.Time         `json:"expiration_date"`
@@ -202,12 +212,12 @@ type Payments struct {
 	UpdatedAt          time.Time         `json:"updated_at"`
 	Metadata           map[string]string `json:"metadata"`
 	RetryCount         uint16            `json:"retry_count"`
-	Discount           string            `json:"discount"`
-	PaidAmount         string            `json:"paid_amount"`
+	Discount           durianpay.Amount  `json:"discount"`
+	PaidAmount         durianpay.Amount  `json:"paid_amount"`
 	ProviderID         string            `json:"provider_id"`
-	TotalFee           string            `json:"total_fee"`
+	TotalFee           durianpay.Amount  `json:"total_fee"`
 	PromoID            string            `json:"promo_id"`
-	ShippingFee        string            `json:"shipping_fee"`
+	ShippingFee        durianpay.Amount  `json:"shipping_fee"`
 	DsErrorMetadata    map[string]string `json:"ds_error_metadata"`
 	CustomerID         string            `json:"customer_id"`
 	GivenName          string            `json:"given_name"`
@@ -226,7 +236,7 @@ type Payment struct {
 	PaymentRefID       string            `json:"payment_ref_id"`
 	PaymentDsRefID     string            `json:"payment_ds_ref_id"`
 	SettlementID       string            `json:"settlement_id"`
-	Amount             string            `json:"amount"`
+	Amount             durianpay.Amount  `json:"amount"`
 	Status             string            `json:"status"`
 	IsLive             bool              `json:"is_live"`
 	ExpirationDate     time.Time         `json:"expiration_date"`
@@ -237,10 +247,10 @@ type Payment struct {
 	Metadata           map[string]string `json:"metadata"`
 	PaymentDetailsType string            `json:"payment_details_type"`
 	MethodID           string            `json:"method_id"`
-	Discount           string            `json:"discount"`
+	Discount           durianpay.Amount  `json:"discount"`
 	PromoID            string            `json:"promo_id"`
-	PaidAmount         string            `json:"paid_amount"`
-	ShippingFee        string            `json:"shipping_fee"`
+	PaidAmount         durianpay.Amount  `json:"paid_amount"`
+	ShippingFee        durianpay.Amount  `json:"shipping_fee"`
 	FailureReason      map[string]string `json:"failure_reason"`
 }
 
@@ -262,9 +272,9 @@ type CheckPaymentStatus struct {
 type Capture struct {
 	PaymentID           string            `json:"payment_id"`
 	OrderID             string            `json:"order_id"`
-	PreauthorizedAmount string            `json:"preauthorized_amount"`
+	PreauthorizedAmount durianpay.Amount  `json:"preauthorized_amount"`
 	AccountID           string            `json:"account_id"`
-	PaidAmount          string            `json:"paid_amount"`
+	PaidAmount          durianpay.Amount  `json:"paid_amount"`
 	Status              string            `json:"status"`
 	CreatedAt           time.Time         `json:"created_at"`
 	UpdatedAt           time.Time         `json:"updated_at"`
@@ -278,7 +288,7 @@ type Cancel struct {
 	PaymentRefID       string            `json:"payment_ref_id"`
 	SettlementID       string            `json:"settlement_id"`
 	PaymentDsRefID     string            `json:"payment_ds_ref_id"`
-	Amount             string            `json:"amount"`
+	Amount             durianpay.Amount  `json:"amount"`
 	Status             string            `json:"status"`
 	IsLive             bool              `json:"is_live"`
 	ExpirationDate     time.Time         `json:"expiration_date"`
@@ -288,12 +298,12 @@ type Cancel struct {
 	UpdatedAt          time.Time         `json:"updated_at"`
 	Metadata           map[string]string `json:"metadata"`
 	RetryCount         uint16            `json:"retry_count"`
-	Discount           string            `json:"discount"`
-	PaidAmount         string            `json:"paid_amount"`
+	Discount           durianpay.Amount  `json:"discount"`
+	PaidAmount         durianpay.Amount  `json:"paid_amount"`
 	ProviderID         string            `json:"provider_id"`
-	TotalFee           string            `json:"total_fee"`
+	TotalFee           durianpay.Amount  `json:"total_fee"`
 	PromoID            string            `json:"promo_id"`
-	ShippingFee        string            `json:"shipping_fee"`
+	ShippingFee        durianpay.Amount  `json:"shipping_fee"`
 	DsErrorMetadata    map[string]string `json:"ds_error_metadata"`
 	FailureReason      map[string]string `json:"failure_reason"`
 	SettlementStatus   string            `json:"settlement_status"`
@@ -301,9 +311,9 @@ type Cancel struct {
 
 // MDRFee is part of MDRFeesCalculation
 type MDRFee struct {
-	ActualAmount float32 `json:"actual_amount"`
-	Fees         float32 `json:"fees"`
-	TotalAmount  float32 `json:"total_amount"`
+	ActualAmount durianpay.Amount `json:"actual_amount"`
+	Fees         durianpay.Amount `json:"fees"`
+	TotalAmount  durianpay.Amount `json:"total_amount"`
 }
 
 // MDRFeesCalculation represents for response MDR Fees Calculation API
@@ -0,0 +1,136 @@
+/*
+ * File Created: Monday, 19th October 2026 1:41:03 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestChargeResponse_Decode(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	var (
+		va          ChargeVA
+		bnpl        ChargeBNPL
+		ewallet     ChargeEwallet
+		retailStore ChargeRetailStore
+		onlineBank  ChargeOnlineBank
+		qris        ChargeQRIS
+		card        ChargeCard
+	)
+
+	tests := []struct {
+		name       string
+		response   string
+		charge     ChargeResult
+		wantType   string
+		wantCommon ChargeCommon
+		// wantField reports whether a field specific to the payment method is decoded
+		wantField func() bool
+	}{
+		{
+			name:       "VA",
+			response:   "charge_va_200.json",
+			charge:     &va,
+			wantType:   "VA",
+			wantCommon: ChargeCommon{PaymentID: "pay_pYQ319c4qo5956", OrderID: "ord_VN5nVJpSW27112"},
+			wantField: func() bool {
+				return va.Response.AccountNumber == "7893572945724867" && va.Response.PaymentInstruction.ID.Atm.Heading == "ATM Mandiri"
+			},
+		},
+		{
+			name:       "BNPL",
+			response:   "charge_bnpl_200.json",
+			charge:     &bnpl,
+			wantType:   "BNPL",
+			wantCommon: ChargeCommon{PaymentID: "pay_80pgxEcUbO8054", OrderID: "ord_NDmLvwTTh95152"},
+			wantField:  func() bool { return bnpl.Response.RedirectURL == "https://redirect-url.com/" },
+		},
+		{
+			name:       "E-Wallet",
+			response:   "charge_ewallet_200.json",
+			charge:     &ewallet,
+			wantType:   "EWALLET",
+			wantCommon: ChargeCommon{PaymentID: "pay_PoVnlDmGts4956", OrderID: "ord_VN5nVJpSW27112"},
+			wantField:  func() bool { return ewallet.Response.CheckoutURL != "" },
+		},
+		{
+			name:       "Retail Store",
+			response:   "charge_retailstore_200.json",
+			charge:     &retailStore,
+			wantType:   "RETAILSTORE",
+			wantCommon: ChargeCommon{PaymentID: "pay_Ln1PZECuqf3748", OrderID: "ord_VN5nVJpSW27112"},
+			wantField:  func() bool { return retailStore.Response.AccountNumber == "1111111111" },
+		},
+		{
+			name:       "Online Banking",
+			response:   "charge_onlinebanking_200.json",
+			charge:     &onlineBank,
+			wantType:   "ONLINE_BANKING",
+			wantCommon: ChargeCommon{PaymentID: "pay_RGEkDpZZWR9662", OrderID: "ord_VN5nVJpSW27112"},
+			wantField:  func() bool { return !onlineBank.Response.ExpirationTime.IsZero() },
+		},
+		{
+			name:       "QRIS",
+			response:   "charge_qris_200.json",
+			charge:     &qris,
+			wantType:   "QRIS",
+			wantCommon: ChargeCommon{PaymentID: "pay_s2sSBlDSWv4167", OrderID: "ord_QETgbs2UGL3100"},
+			wantField:  func() bool { return qris.Response.QRString != "" && !qris.Response.Amount.IsZero() },
+		},
+		{
+			name:       "Card",
+			response:   "charge_card_200.json",
+			charge:     &card,
+			wantType:   "CARD",
+			wantCommon: ChargeCommon{PaymentID: "pay_TMlTVT3wvr3598", OrderID: "ord_Gf7LimyjMk7270"},
+			wantField:  func() bool { return card.Response.CheckoutURL == "https://link.to/card-checkout-url" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := struct {
+				Data any `json:"data"`
+			}{Data: tt.charge}
+
+			if err := json.Unmarshal(featureWrap.ResJSONByte(pathResponsePayment+tt.response), &res); err != nil {
+				t.Fatal(err)
+			}
+
+			if got := tt.charge.ChargeType(); got != tt.wantType {
+				t.Errorf("ChargeType() = %s, want %s", got, tt.wantType)
+			}
+
+			if got := (ChargeCommon{PaymentID: tt.charge.PaymentID(), OrderID: tt.charge.OrderID()}); got != tt.wantCommon {
+				t.Errorf("PaymentID() & OrderID() = %+v, want %+v", got, tt.wantCommon)
+			}
+
+			if !tt.wantField() {
+				t.Errorf("Response = %+v, field of the payment method is not decoded", tt.charge)
+			}
+
+			// Encoding uses the tags only, so a broken tag shows as "Response" key
+			encoded, err := json.Marshal(tt.charge)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var keys map[string]json.RawMessage
+			if err := json.Unmarshal(encoded, &keys); err != nil {
+				t.Fatal(err)
+			}
+
+			if _, ok := keys["response"]; !ok || len(keys) != 2 {
+				t.Errorf("json.Marshal() = %s, want type & response keys", encoded)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+/*
+ * File Created: Monday, 19th October 2026 12:24:38 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+// Signature returns verification signature of a payment.
+// The signature is hex encoded HMAC SHA256 of "order_id|payment_id" with the server key as secret.
+func Signature(serverKey, orderID, paymentID string) string {
+	return hex.EncodeToString(signature(serverKey, orderID, paymentID))
+}
+
+// VerifySignature reports whether signature is valid for the payment, without any request to DurianPay.
+// The comparison is done in constant time.
+func VerifySignature(serverKey, orderID, paymentID, sig string) bool {
+	decoded, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(decoded, signature(serverKey, orderID, paymentID))
+}
+
+func signature(serverKey, orderID, paymentID string) []byte {
+	mac := hmac.New(sha256.New, []byte(serverKey))
+	mac.Write([]byte(orderID + "|" + paymentID))
+
+	return mac.Sum(nil)
+}
+
+// VerifySignature verifies verification_signature from checkout success redirect or webhook payload
+// using the client ServerKey. Set opt.RemoteFallback to call Verify Payments API when local verification fails.
+func (c *Client) VerifySignature(ctx context.Context, orderID, paymentID, sig string, opt durianpay.PaymentVerifySignatureOption) (bool, *durianpay.Error) {
+	if VerifySignature(c.ServerKey, orderID, paymentID, sig) {
+		return true, nil
+	}
+
+	if !opt.RemoteFallback {
+		return false, nil
+	}
+
+	return c.Verify(ctx, paymentID, durianpay.PaymentVerifyPayload{VerificationSignature: sig})
+}
@@ -0,0 +1,163 @@
+/*
+ * File Created: Monday, 19th October 2026 12:24:38 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/golang/mock/gomock"
+)
+
+const (
+	validSignature = "8450ccee779745741fa1c50ea5a438dd8564594bc5ece6ba20e91473e2db0e30"
+)
+
+func TestVerifySignature(t *testing.T) {
+	type args struct {
+		serverKey string
+		orderID   string
+		paymentID string
+		sig       string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Valid",
+			args: args{"dpay_test_xxx", "ord_VN5nVJpSW27112", "pay_pYQ319c4qo5956", validSignature},
+			want: true,
+		},
+		{
+			name: "Valid uppercase hex",
+			args: args{"dpay_test_xxx", "ord_VN5nVJpSW27112", "pay_pYQ319c4qo5956", strings.ToUpper(validSignature)},
+			want: true,
+		},
+		{
+			name: "Different server key",
+			args: args{"dpay_live_xxx", "ord_VN5nVJpSW27112", "pay_pYQ319c4qo5956", validSignature},
+			want: false,
+		},
+		{
+			name: "Swapped identifiers",
+			args: args{"dpay_test_xxx", "pay_pYQ319c4qo5956", "ord_VN5nVJpSW27112", validSignature},
+			want: false,
+		},
+		{
+			name: "Not hex",
+			args: args{"dpay_test_xxx", "ord_VN5nVJpSW27112", "pay_pYQ319c4qo5956", "not-a-signature"},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := VerifySignature(tt.args.serverKey, tt.args.orderID, tt.args.paymentID, tt.args.sig); got != tt.want {
+				t.Errorf("VerifySignature() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := Signature("dpay_test_xxx", "ord_VN5nVJpSW27112", "pay_pYQ319c4qo5956"); got != validSignature {
+		t.Errorf("Signature() = %v, want %v", got, validSignature)
+	}
+}
+
+func TestClient_VerifySignature(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	type args struct {
+		ctx       context.Context
+		orderID   string
+		paymentID string
+		sig       string
+		opt       durianpay.PaymentVerifySignatureOption
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(m mocks, args args)
+		wantRes bool
+		wantErr *durianpay.Error
+	}{
+		{
+			name: "Valid locally without request",
+			args: args{
+				ctx:       context.Background(),
+				orderID:   "ord_VN5nVJpSW27112",
+				paymentID: "pay_pYQ319c4qo5956",
+				sig:       validSignature,
+				opt:       durianpay.PaymentVerifySignatureOption{RemoteFallback: true},
+			},
+			prepare: func(m mocks, args args) {},
+			wantRes: true,
+		},
+		{
+			name: "Invalid locally without fallback",
+			args: args{
+				ctx:       context.Background(),
+				orderID:   "ord_VN5nVJpSW27112",
+				paymentID: "pay_pYQ319c4qo5956",
+				sig:       "adf9a1a37af514c91225f6680e2df723fefebb7638519bcc7e7c9de02f2a3ab2",
+			},
+			prepare: func(m mocks, args args) {},
+			wantRes: false,
+		},
+		{
+			name: "Invalid locally with remote fallback",
+			args: args{
+				ctx:       context.Background(),
+				orderID:   "ord_VN5nVJpSW27112",
+				paymentID: "pay_pYQ319c4qo5956",
+				sig:       "adf9a1a37af514c91225f6680e2df723fefebb7638519bcc7e7c9de02f2a3ab2",
+				opt:       durianpay.PaymentVerifySignatureOption{RemoteFallback: true},
+			},
+			prepare: func(m mocks, args args) {
+				url := strings.ReplaceAll(pathVerify, ":id", args.paymentID)
+				payload := durianpay.PaymentVerifyPayload{VerificationSignature: args.sig}
+
+				m.api.EXPECT().
+					Req(gomock.Any(), "POST", url, nil, payload, nil, gomock.Any()).
+					Return(durianpay.FromAPI(500, featureWrap.ResJSONByte(pathResponse+"internal_server_error_500.json")))
+			},
+			wantRes: false,
+			wantErr: durianpay.FromAPI(500, featureWrap.ResJSONByte(pathResponse+"internal_server_error_500.json")),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+			c := &Client{
+				ServerKey: featureWrap.ServerKey,
+				Api:       apiMock,
+			}
+
+			tt.prepare(mocks{api: apiMock}, tt.args)
+
+			gotRes, gotErr := c.VerifySignature(tt.args.ctx, tt.args.orderID, tt.args.paymentID, tt.args.sig, tt.args.opt)
+			if gotRes != tt.wantRes {
+				t.Errorf("Client.VerifySignature() gotRes = %v, want %v", gotRes, tt.wantRes)
+			}
+
+			if !reflect.DeepEqual(gotErr, tt.wantErr) {
+				t.Errorf("Client.VerifySignature() gotErr = %v, want %v", gotErr, tt.wantErr)
+			}
+		})
+	}
+}
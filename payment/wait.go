@@ -0,0 +1,101 @@
+/*
+ * File Created: Monday, 19th October 2026 1:42:00 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
+
+const (
+	DefaultWaitInterval    = 2 * time.Second
+	DefaultWaitMaxInterval = 30 * time.Second
+)
+
+// WaitOption is option of Client.WaitForPayment, zero value uses the defaults.
+type WaitOption struct {
+	Interval    time.Duration // Delay after the first check, doubled after every check. Default DefaultWaitInterval
+	MaxInterval time.Duration // Default DefaultWaitMaxInterval
+	// OnStatus is called with the first status and every time the status changes, ex: to show progress to the customer
+	OnStatus    func(status CheckPaymentStatus)
+	FetchOption durianpay.PaymentFetchByIDOption // Used to fetch the payment after terminal status
+}
+
+// WaitForPayment polls Check Payments Status API with exponential backoff until the payment has terminal status
+// (completed, failed, expired or cancelled), then returns the payment from Payment Fetch by ID API.
+// Retryable errors of Check Payments Status API (5xx, 429, connection error or timeout of the request) are retried with
+// the same backoff, other errors are returned immediately.
+// Use ctx with deadline to limit the wait, the error is durianpay.ErrorCodeSDKTimeout when the deadline is exceeded.
+func (c *Client) WaitForPayment(ctx context.Context, paymentID string, opt WaitOption) (*Payment, *durianpay.Error) {
+	interval, maxInterval := opt.Interval, opt.MaxInterval
+	if interval <= 0 {
+		interval = DefaultWaitInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultWaitMaxInterval
+	}
+	if interval > maxInterval {
+		interval = maxInterval
+	}
+
+	var lastStatus *CheckPaymentStatus
+	for {
+		status, err := c.CheckPaymentStatus(ctx, paymentID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, durianpay.FromSDKError(ctx.Err())
+			}
+			if !isRetryable(err) {
+				return nil, err
+			}
+		} else {
+			if opt.OnStatus != nil && (lastStatus == nil || lastStatus.Status != status.Status) {
+				opt.OnStatus(*status)
+			}
+			lastStatus = status
+
+			if status.IsCompleted || isTerminalStatus(status.Status) {
+				return c.FetchPaymentByID(ctx, paymentID, opt.FetchOption)
+			}
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, durianpay.FromSDKError(ctx.Err())
+		case <-timer.C:
+		}
+
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
+
+// isRetryable reports whether the request may succeed when it is sent again.
+func isRetryable(err *durianpay.Error) bool {
+	switch err.ErrorCode {
+	case durianpay.ErrorCodeSDKConnection, durianpay.ErrorCodeSDKTimeout:
+		return true
+	}
+
+	return err.StatusCode >= http.StatusInternalServerError || err.StatusCode == http.StatusTooManyRequests
+}
+
+// isTerminalStatus reports whether status of a payment will not change anymore.
+func isTerminalStatus(status string) bool {
+	switch status {
+	case "completed", "failed", "expired", "cancelled":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,193 @@
+/*
+ * File Created: Monday, 19th October 2026 1:42:00 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/golang/mock/gomock"
+)
+
+// mockStatus returns DoAndReturn function which decodes status into response of Check Payments Status API.
+func mockStatus(status string) func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+	return func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+		data := `{"data":{"status":"` + status + `","is_completed":` + strconv.FormatBool(status == "completed") + `}}`
+		if err := json.Unmarshal([]byte(data), response); err != nil {
+			panic(err)
+		}
+
+		return nil
+	}
+}
+
+func TestClient_WaitForPayment(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	paymentID := "pay_Ln1PZECuqf3748"
+	statusURL := strings.ReplaceAll(pathCheckStatus, ":id", paymentID)
+	fetchURL := strings.ReplaceAll(pathFetchByID, ":id", paymentID)
+
+	tests := []struct {
+		name         string
+		statuses     []string
+		wantStatuses []string
+	}{
+		{
+			name:         "Completed",
+			statuses:     []string{"processing", "processing", "processing", "completed"},
+			wantStatuses: []string{"processing", "completed"},
+		},
+		{
+			name:         "Expired",
+			statuses:     []string{"started", "processing", "expired"},
+			wantStatuses: []string{"started", "processing", "expired"},
+		},
+		{
+			name:         "Already failed",
+			statuses:     []string{"failed"},
+			wantStatuses: []string{"failed"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+			c := &Client{
+				ServerKey: featureWrap.ServerKey,
+				Api:       apiMock,
+			}
+
+			var calls []*gomock.Call
+			for _, status := range tt.statuses {
+				calls = append(calls, apiMock.EXPECT().
+					Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+					DoAndReturn(mockStatus(status)))
+			}
+
+			fetchOpt := durianpay.PaymentFetchByIDOption{Expand: "customer"}
+			calls = append(calls, apiMock.EXPECT().
+				Req(gomock.Any(), "GET", fetchURL, fetchOpt, nil, nil, gomock.Any()).
+				DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+					if err := json.Unmarshal(featureWrap.ResJSONByte(pathResponsePayment+"fetch_payment_200.json"), response); err != nil {
+						panic(err)
+					}
+
+					return nil
+				}))
+			gomock.InOrder(calls...)
+
+			var gotStatuses []string
+			got, err := c.WaitForPayment(context.Background(), paymentID, WaitOption{
+				Interval:    time.Millisecond,
+				MaxInterval: 2 * time.Millisecond,
+				OnStatus:    func(status CheckPaymentStatus) { gotStatuses = append(gotStatuses, status.Status) },
+				FetchOption: fetchOpt,
+			})
+			if err != nil {
+				t.Fatalf("Client.WaitForPayment() error = %v", err)
+			}
+
+			if got.ID != paymentID {
+				t.Errorf("Client.WaitForPayment() = %+v, want payment %s", got, paymentID)
+			}
+
+			if !reflect.DeepEqual(gotStatuses, tt.wantStatuses) {
+				t.Errorf("Client.WaitForPayment() statuses = %v, want %v", gotStatuses, tt.wantStatuses)
+			}
+		})
+	}
+}
+
+func TestClient_WaitForPayment_Error(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	statusURL := strings.ReplaceAll(pathCheckStatus, ":id", "pay_1")
+
+	t.Run("Deadline exceeded", func(t *testing.T) {
+		apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+		c := &Client{ServerKey: featureWrap.ServerKey, Api: apiMock}
+
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+			DoAndReturn(mockStatus("processing")).
+			MinTimes(1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		got, err := c.WaitForPayment(ctx, "pay_1", WaitOption{Interval: time.Millisecond, MaxInterval: 5 * time.Millisecond})
+		if got != nil || err == nil || !err.IsTimeout() {
+			t.Errorf("Client.WaitForPayment() = %v, %v, want timeout error", got, err)
+		}
+	})
+
+	t.Run("Retryable check status error", func(t *testing.T) {
+		apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+		c := &Client{ServerKey: featureWrap.ServerKey, Api: apiMock}
+
+		gomock.InOrder(
+			apiMock.EXPECT().
+				Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+				Return(&durianpay.Error{StatusCode: 500, ErrorCode: durianpay.ErrorCodeDPAYInternalError}),
+			apiMock.EXPECT().
+				Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+				Return(&durianpay.Error{ErrorCode: durianpay.ErrorCodeSDKConnection}),
+			apiMock.EXPECT().
+				Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+				DoAndReturn(mockStatus("completed")),
+			apiMock.EXPECT().
+				Req(gomock.Any(), "GET", strings.ReplaceAll(pathFetchByID, ":id", "pay_1"), gomock.Any(), nil, nil, gomock.Any()).
+				Return(nil),
+		)
+
+		if _, err := c.WaitForPayment(context.Background(), "pay_1", WaitOption{Interval: time.Millisecond}); err != nil {
+			t.Errorf("Client.WaitForPayment() error = %v, want nil after retry", err)
+		}
+	})
+
+	t.Run("Non-retryable check status error", func(t *testing.T) {
+		apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+		c := &Client{ServerKey: featureWrap.ServerKey, Api: apiMock}
+
+		wantErr := &durianpay.Error{StatusCode: 400, ErrorCode: durianpay.ErrorCodeDPAYInvalidRequest}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+			Return(wantErr)
+
+		if got, err := c.WaitForPayment(context.Background(), "pay_1", WaitOption{}); got != nil || err != wantErr {
+			t.Errorf("Client.WaitForPayment() = %v, %v, want %v", got, err, wantErr)
+		}
+	})
+
+	t.Run("Retryable error until deadline", func(t *testing.T) {
+		apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+		c := &Client{ServerKey: featureWrap.ServerKey, Api: apiMock}
+
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", statusURL, nil, nil, nil, gomock.Any()).
+			Return(&durianpay.Error{StatusCode: 503, ErrorCode: durianpay.ErrorCodeDPAYInternalError}).
+			MinTimes(1)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		got, err := c.WaitForPayment(ctx, "pay_1", WaitOption{Interval: time.Millisecond, MaxInterval: 5 * time.Millisecond})
+		if got != nil || err == nil || !err.IsTimeout() {
+			t.Errorf("Client.WaitForPayment() = %v, %v, want timeout error", got, err)
+		}
+	})
+}
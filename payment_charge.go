@@ -0,0 +1,71 @@
+/*
+ * File Created: Monday, 19th October 2026 1:35:13 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+// Types of Payment Charge API
+const (
+	ChargeTypeVA            = "VA"
+	ChargeTypeBNPL          = "BNPL"
+	ChargeTypeEwallet       = "EWALLET"
+	ChargeTypeRetailStore   = "RETAILSTORE"
+	ChargeTypeOnlineBanking = "ONLINE_BANKING"
+	ChargeTypeQRIS          = "QRIS"
+	ChargeTypeCard          = "CARD"
+)
+
+// ChargeRequest is payload of Payment Charge API for any payment method, see payment.Client.Charge.
+// It is sealed, only PaymentCharge...Payload of this package implement it.
+type ChargeRequest interface {
+	// ChargeType returns type of Payment Charge API, ex: ChargeTypeVA
+	ChargeType() string
+	// ChargeSandboxOption returns sandbox option, nil for payload without sandbox option
+	ChargeSandboxOption() *PaymentSandboxOption
+
+	isChargeRequest()
+}
+
+func (p PaymentChargeVAPayload) ChargeType() string { return ChargeTypeVA }
+func (p PaymentChargeVAPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return p.SandboxOption
+}
+func (PaymentChargeVAPayload) isChargeRequest() {}
+
+func (p PaymentChargeBNPLPayload) ChargeType() string { return ChargeTypeBNPL }
+func (p PaymentChargeBNPLPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return p.SandboxOption
+}
+func (PaymentChargeBNPLPayload) isChargeRequest() {}
+
+func (p PaymentChargeEwalletPayload) ChargeType() string { return ChargeTypeEwallet }
+func (p PaymentChargeEwalletPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return p.SandboxOption
+}
+func (PaymentChargeEwalletPayload) isChargeRequest() {}
+
+func (p PaymentChargeRetailStorePayload) ChargeType() string { return ChargeTypeRetailStore }
+func (p PaymentChargeRetailStorePayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return p.SandboxOption
+}
+func (PaymentChargeRetailStorePayload) isChargeRequest() {}
+
+func (p PaymentChargeOnlineBankingPayload) ChargeType() string { return ChargeTypeOnlineBanking }
+func (p PaymentChargeOnlineBankingPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return nil
+}
+func (PaymentChargeOnlineBankingPayload) isChargeRequest() {}
+
+func (p PaymentChargeQRISPayload) ChargeType() string { return ChargeTypeQRIS }
+func (p PaymentChargeQRISPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return nil
+}
+func (PaymentChargeQRISPayload) isChargeRequest() {}
+
+func (p PaymentChargeCardPayload) ChargeType() string { return ChargeTypeCard }
+func (p PaymentChargeCardPayload) ChargeSandboxOption() *PaymentSandboxOption {
+	return nil
+}
+func (PaymentChargeCardPayload) isChargeRequest() {}
@@ -0,0 +1,230 @@
+/*
+ * File Created: Monday, 19th October 2026 12:45:35 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrInvalidPhone is returned when phone number is not a valid Indonesian mobile number.
+var ErrInvalidPhone = errors.New("durianpay: invalid phone number")
+
+// PhoneFormat is format of phone number expected by DurianPay endpoint.
+type PhoneFormat string
+
+const (
+	PhoneFormatLocal    PhoneFormat = "local"    // 08123456789
+	PhoneFormatNational PhoneFormat = "national" // 8123456789
+	PhoneFormatE164     PhoneFormat = "e164"     // +628123456789
+	PhoneFormatIntl     PhoneFormat = "intl"     // 628123456789
+)
+
+// Operator is Indonesian mobile operator.
+type Operator string
+
+const (
+	OperatorUnknown   Operator = ""
+	OperatorTelkomsel Operator = "TELKOMSEL"
+	OperatorIndosat   Operator = "INDOSAT"
+	OperatorXL        Operator = "XL"
+	OperatorAxis      Operator = "AXIS"
+	OperatorTri       Operator = "TRI"
+	OperatorSmartfren Operator = "SMARTFREN"
+)
+
+// operatorPrefixes is operator by first 3 digits of national number.
+var operatorPrefixes = map[string]Operator{
+	"811": OperatorTelkomsel, "812": OperatorTelkomsel, "813": OperatorTelkomsel,
+	"821": OperatorTelkomsel, "822": OperatorTelkomsel, "823": OperatorTelkomsel,
+	"851": OperatorTelkomsel, "852": OperatorTelkomsel, "853": OperatorTelkomsel,
+	"814": OperatorIndosat, "815": OperatorIndosat, "816": OperatorIndosat,
+	"855": OperatorIndosat, "856": OperatorIndosat, "857": OperatorIndosat, "858": OperatorIndosat,
+	"817": OperatorXL, "818": OperatorXL, "819": OperatorXL,
+	"859": OperatorXL, "877": OperatorXL, "878": OperatorXL,
+	"831": OperatorAxis, "832": OperatorAxis, "833": OperatorAxis, "838": OperatorAxis,
+	"895": OperatorTri, "896": OperatorTri, "897": OperatorTri, "898": OperatorTri, "899": OperatorTri,
+	"881": OperatorSmartfren, "882": OperatorSmartfren, "883": OperatorSmartfren, "884": OperatorSmartfren,
+	"885": OperatorSmartfren, "886": OperatorSmartfren, "887": OperatorSmartfren, "888": OperatorSmartfren,
+	"889": OperatorSmartfren,
+}
+
+// Phone is Indonesian mobile number.
+type Phone struct {
+	national string // Number without country code & leading zero, ex: 8123456789
+}
+
+// ParsePhone parses Indonesian mobile number in format 08xx, 8xx, 628xx or +628xx.
+// Spaces, dashes, dots & parentheses are ignored.
+func ParsePhone(s string) (Phone, error) {
+	number := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, s)
+
+	number = strings.TrimPrefix(number, "+")
+	switch {
+	case strings.HasPrefix(number, "62"):
+		number = number[2:]
+	case strings.HasPrefix(number, "0"):
+		number = number[1:]
+	}
+
+	// Mobile number starts with 8 and has 9 to 12 digits without leading zero
+	if !strings.HasPrefix(number, "8") || len(number) < 9 || len(number) > 12 || !isDigits(number) {
+		return Phone{}, fmt.Errorf("%w: %q", ErrInvalidPhone, s)
+	}
+
+	return Phone{national: number}, nil
+}
+
+// Format returns phone number in format f.
+func (p Phone) Format(f PhoneFormat) string {
+	switch f {
+	case PhoneFormatLocal:
+		return "0" + p.national
+	case PhoneFormatE164:
+		return "+62" + p.national
+	case PhoneFormatIntl:
+		return "62" + p.national
+	}
+
+	return p.national
+}
+
+// String returns phone number in E.164 format, ex: +628123456789.
+func (p Phone) String() string {
+	return p.Format(PhoneFormatE164)
+}
+
+// Operator returns mobile operator of phone number based on its prefix.
+func (p Phone) Operator() Operator {
+	if len(p.national) < 3 {
+		return OperatorUnknown
+	}
+
+	return operatorPrefixes[p.national[:3]]
+}
+
+// NormalizePhones returns copy of payload with every field tagged `phone` converted to format.
+// DurianPay does not document the format of each phone field, so the format is chosen by the caller.
+// Empty numbers are kept, invalid numbers are returned as Error with ErrorCodeSDKValidation.
+func NormalizePhones(payload any, format PhoneFormat) (any, *Error) {
+	if payload == nil {
+		return nil, nil
+	}
+
+	errs := []Errors{}
+	normalized := normalizePhones(reflect.ValueOf(payload), format, "", &errs)
+
+	if err := FromValidationErrors("invalid phone number", errs); err != nil {
+		return payload, err
+	}
+
+	return normalized.Interface(), nil
+}
+
+// normalizePhones returns copy of v with normalized phone numbers, slices & pointers are copied so payload is never modified.
+func normalizePhones(v reflect.Value, format PhoneFormat, path string, errs *[]Errors) reflect.Value {
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		p := reflect.New(v.Type().Elem())
+		p.Elem().Set(normalizePhones(v.Elem(), format, path, errs))
+		return p
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		i := reflect.New(v.Type()).Elem()
+		i.Set(normalizePhones(v.Elem(), format, path, errs))
+		return i
+	case reflect.Slice:
+		if v.IsNil() || !hasPhoneTag(v.Type().Elem()) {
+			return v
+		}
+		s := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			s.Index(i).Set(normalizePhones(v.Index(i), format, fmt.Sprintf("%s[%d]", path, i), errs))
+		}
+		return s
+	case reflect.Struct:
+		if !hasPhoneTag(v.Type()) {
+			return v
+		}
+	default:
+		return v
+	}
+
+	t := v.Type()
+	s := reflect.New(t).Elem()
+	s.Set(v)
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		fieldPath := joinFieldPath(path, validateFieldName(sf))
+		if _, ok := sf.Tag.Lookup("phone"); !ok || sf.Type.Kind() != reflect.String {
+			s.Field(i).Set(normalizePhones(v.Field(i), format, fieldPath, errs))
+			continue
+		}
+
+		number := v.Field(i).String()
+		if strings.TrimSpace(number) == "" {
+			continue
+		}
+
+		phone, err := ParsePhone(number)
+		if err != nil {
+			*errs = append(*errs, Errors{Field: fieldPath, Message: fmt.Sprintf("%s %s", sf.Tag.Get("json"), strings.TrimPrefix(err.Error(), ErrInvalidPhone.Error()+": "))})
+			continue
+		}
+
+		s.Field(i).SetString(phone.Format(format))
+	}
+
+	return s
+}
+
+// hasPhoneTag reports whether t or any of its nested fields has phone tag.
+func hasPhoneTag(t reflect.Type) bool {
+	return hasPhoneTagSeen(t, map[reflect.Type]bool{})
+}
+
+func hasPhoneTagSeen(t reflect.Type, seen map[reflect.Type]bool) bool {
+	for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+		t = t.Elem()
+	}
+
+	if t.Kind() == reflect.Interface {
+		return true
+	}
+
+	if t.Kind() != reflect.Struct || seen[t] {
+		return false
+	}
+	seen[t] = true
+
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if _, ok := sf.Tag.Lookup("phone"); ok || (sf.IsExported() && hasPhoneTagSeen(sf.Type, seen)) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,115 @@
+/*
+ * File Created: Monday, 19th October 2026 12:45:35 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		s            string
+		wantLocal    string
+		wantOperator Operator
+		wantErr      bool
+	}{
+		{s: "081234567890", wantLocal: "081234567890", wantOperator: OperatorTelkomsel},
+		{s: "+62 857-2217-3217", wantLocal: "085722173217", wantOperator: OperatorIndosat},
+		{s: "6287712345678", wantLocal: "087712345678", wantOperator: OperatorXL},
+		{s: "8987654321", wantLocal: "08987654321", wantOperator: OperatorTri},
+		{s: "(0888) 8888 8888", wantLocal: "088888888888", wantOperator: OperatorSmartfren},
+		{s: "0810123456", wantLocal: "0810123456", wantOperator: OperatorUnknown},
+		{s: "021-555-1234", wantErr: true},
+		{s: "+6288888888", wantErr: true},
+		{s: "08123456789012", wantErr: true},
+		{s: "0812abc4567", wantErr: true},
+		{s: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.s, func(t *testing.T) {
+			got, err := ParsePhone(tt.s)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParsePhone() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				if !errors.Is(err, ErrInvalidPhone) {
+					t.Errorf("ParsePhone() error = %v, want ErrInvalidPhone", err)
+				}
+				return
+			}
+
+			if got.Format(PhoneFormatLocal) != tt.wantLocal {
+				t.Errorf("Phone.Format() = %v, want %v", got.Format(PhoneFormatLocal), tt.wantLocal)
+			}
+
+			if got.Operator() != tt.wantOperator {
+				t.Errorf("Phone.Operator() = %v, want %v", got.Operator(), tt.wantOperator)
+			}
+		})
+	}
+}
+
+func TestPhone_Format(t *testing.T) {
+	p, _ := ParsePhone("0812 3456 789")
+
+	want := map[PhoneFormat]string{
+		PhoneFormatLocal:    "08123456789",
+		PhoneFormatNational: "8123456789",
+		PhoneFormatE164:     "+628123456789",
+		PhoneFormatIntl:     "628123456789",
+	}
+
+	for format, w := range want {
+		if got := p.Format(format); got != w {
+			t.Errorf("Phone.Format(%s) = %v, want %v", format, got, w)
+		}
+	}
+}
+
+func TestNormalizePhones(t *testing.T) {
+	payload := &DisbursementPayload{
+		Name: "Salary",
+		Items: []DisbursementItemPayload{
+			{AccountOwnerName: "Goodman", PhoneNumber: "+62 812-3456-7890"},
+			{AccountOwnerName: "Jane"},
+		},
+	}
+
+	got, err := NormalizePhones(payload, PhoneFormatLocal)
+	if err != nil {
+		t.Fatalf("NormalizePhones() error = %v", err.Errors)
+	}
+
+	if phone := got.(*DisbursementPayload).Items[0].PhoneNumber; phone != "081234567890" {
+		t.Errorf("NormalizePhones() phone_number = %v, want %v", phone, "081234567890")
+	}
+
+	if payload.Items[0].PhoneNumber != "+62 812-3456-7890" {
+		t.Errorf("NormalizePhones() modified payload, phone_number = %v", payload.Items[0].PhoneNumber)
+	}
+
+	order, err := NormalizePhones(OrderPayload{
+		Customer: Customer{Mobile: "085722173217", Address: CustomerAddress{ReceiverPhone: "+628987654321"}},
+	}, PhoneFormatNational)
+	if err != nil {
+		t.Fatalf("NormalizePhones() error = %v", err.Errors)
+	}
+
+	if customer := order.(OrderPayload).Customer; customer.Mobile != "85722173217" || customer.Address.ReceiverPhone != "8987654321" {
+		t.Errorf("NormalizePhones() customer = %+v", customer)
+	}
+
+	_, err = NormalizePhones(PaymentChargeEwalletPayload{Mobile: "021-555-1234", WalletType: "OVO"}, PhoneFormatLocal)
+	want := []Errors{{Field: "mobile", Message: `mobile "021-555-1234"`}}
+	if err == nil || err.ErrorCode != ErrorCodeSDKValidation || !reflect.DeepEqual(err.Errors, want) {
+		t.Errorf("NormalizePhones() error = %v, want %v", err, want)
+	}
+}
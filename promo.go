@@ -12,30 +12,71 @@ import "time"
 Payloads
 */
 
+// PromoType is type of promo, it decides which payment methods get the discount.
+type PromoType string
+
+const (
+	PromoTypeCard    PromoType = "card_promos"    // Card payments, filtered by PromoDetails.BinList or PromoDetails.BankCodes
+	PromoTypeVA      PromoType = "va_promos"      // Virtual Account payments of PromoDetails.BankCodes
+	PromoTypeEwallet PromoType = "ewallet_promos" // E-Wallet payments of PromoDetails.BankCodes, ex: OVO
+)
+
+// PromoDiscountType is how Discount of promo is applied.
+type PromoDiscountType string
+
+const (
+	PromoDiscountPercentage PromoDiscountType = "percentage" // Discount is percentage of the order amount, ex: "10" is 10%
+	PromoDiscountFlat       PromoDiscountType = "flat"       // Discount is fixed amount, ex: "10000"
+)
+
+// PromoSubType is how promo is redeemed.
+type PromoSubType string
+
+const (
+	PromoSubTypeDirectDiscount PromoSubType = "direct_discount" // Applied automatically
+	PromoSubTypeDiscountCode   PromoSubType = "discount_code"   // Applied when customer enters Code
+)
+
+// PromoLimitType is how usage of promo is limited by LimitValue.
+type PromoLimitType string
+
+const (
+	PromoLimitQuota  PromoLimitType = "quota"  // LimitValue is number of usage
+	PromoLimitBudget PromoLimitType = "budget" // LimitValue is total discount amount
+)
+
+// PromoPriceDeductionType is which price of order is discounted.
+type PromoPriceDeductionType string
+
+const (
+	PromoDeductTotalPrice  PromoPriceDeductionType = "total_price"
+	PromoDeductShippingFee PromoPriceDeductionType = "shipping_fee"
+)
+
 // PromoPayload use for Create & Update Promo API
 type PromoPayload struct {
-	Type               string       `json:"type"`
-	Label              string       `json:"label"`
-	Currency           string       `json:"currency"`
-	PromoDetails       PromoDetails `json:"promo_details"`
-	DiscountType       string       `json:"discount_type"`
-	Discount           string       `json:"discount"`
-	MinOrderAmount     string       `json:"min_order_amount"`
-	MaxDiscountAmount  string       `json:"max_discount_amount"`
-	StartsAt           time.Time    `json:"starts_at"`
-	EndsAt             time.Time    `json:"ends_at"`
-	PromoType          string       `json:"promo_type"`
-	Description        string       `json:"description"`
-	SubType            string       `json:"sub_type"`
-	LimitType          string       `json:"limit_type"`
-	LimitValue         string       `json:"limit_value"`
-	PriceDeductionType string       `json:"price_deduction_type"`
-	Code               string       `json:"code"`
+	Type               PromoType               `json:"type" validate:"required,oneof=card_promos va_promos ewallet_promos"`
+	Label              string                  `json:"label" validate:"required"`
+	Currency           string                  `json:"currency" validate:"oneof=IDR"`
+	PromoDetails       PromoDetails            `json:"promo_details"`
+	DiscountType       PromoDiscountType       `json:"discount_type" validate:"required,oneof=percentage flat"`
+	Discount           string                  `json:"discount" validate:"required,amount"`
+	MinOrderAmount     Amount                  `json:"min_order_amount" validate:"amount"`
+	MaxDiscountAmount  Amount                  `json:"max_discount_amount" validate:"amount"`
+	StartsAt           time.Time               `json:"starts_at" validate:"required"`
+	EndsAt             time.Time               `json:"ends_at" validate:"required"`
+	PromoType          string                  `json:"promo_type"`
+	Description        string                  `json:"description"`
+	SubType            PromoSubType            `json:"sub_type" validate:"required,oneof=direct_discount discount_code"`
+	LimitType          PromoLimitType          `json:"limit_type" validate:"oneof=quota budget"`
+	LimitValue         string                  `json:"limit_value"`
+	PriceDeductionType PromoPriceDeductionType `json:"price_deduction_type" validate:"oneof=total_price shipping_fee"`
+	Code               string                  `json:"code"`
 }
 
 // PromoDetails is part of PromoPayload
 type PromoDetails struct {
-	BinList   []int    `json:"bin_list"`
+	BinList   []int    `json:"bin_list"` // 6 or 8 digits BIN, BIN with leading zero cannot be represented
 	BankCodes []string `json:"bank_codes"`
 }
 
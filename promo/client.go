@@ -28,6 +28,7 @@ const (
 )
 
 // Create return a response from Create Promos API.
+// Payload is checked with durianpay.ValidatePromo by Api, see common.ApiImplement.Req
 //
 //	[Doc Create Promos API]: https://durianpay.id/docs/api/promos/create/
 func (c *Client) Create(ctx context.Context, payload durianpay.PromoPayload) (*Promo, *durianpay.Error) {
@@ -94,6 +95,7 @@ func (c *Client) Delete(ctx context.Context, ID string) (string, *durianpay.Erro
 }
 
 // Update return a response from Update Promos API.
+// Payload is checked with durianpay.ValidatePromo by Api, see common.ApiImplement.Req
 //
 //	[Doc Update Promos API]: https://durianpay.id/docs/api/promos/update/
 func (c *Client) Update(ctx context.Context, ID string, payload durianpay.PromoPayload) (*Promo, *durianpay.Error) {
@@ -29,6 +29,18 @@ type mocks struct {
 	api *mock_common.MockApi
 }
 
+// validPromo is minimal payload which passes durianpay.ValidatePromo
+var validPromo = durianpay.PromoPayload{
+	Type:         durianpay.PromoTypeCard,
+	Label:        "SALE2022",
+	PromoDetails: durianpay.PromoDetails{BinList: []int{424242}},
+	DiscountType: durianpay.PromoDiscountFlat,
+	Discount:     "10000",
+	StartsAt:     tests.StringToTime("2022-02-24T18:30:00.000Z"),
+	EndsAt:       tests.StringToTime("2022-02-27T18:30:00.000Z"),
+	SubType:      durianpay.PromoSubTypeDirectDiscount,
+}
+
 func TestClient_Create(t *testing.T) {
 	featureWrap := tests.FeatureWrap(t)
 	defer featureWrap.Ctrl.Finish()
@@ -106,7 +118,8 @@ func TestClient_Create(t *testing.T) {
 		{
 			name: "Internal Server Error",
 			args: args{
-				ctx: context.Background(),
+				ctx:     context.Background(),
+				payload: validPromo,
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
@@ -495,8 +508,9 @@ func TestClient_Update(t *testing.T) {
 		{
 			name: "Internal Server Error",
 			args: args{
-				ctx: context.Background(),
-				ID:  "prm_3eTlttAEF84045",
+				ctx:     context.Background(),
+				ID:      "prm_3eTlttAEF84045",
+				payload: validPromo,
 			},
 			prepare: func(m mocks, args args) {
 				url := strings.ReplaceAll(pathUpdateByID, ":id", args.ID)
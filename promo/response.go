@@ -6,31 +6,35 @@
  */
 package promo
 
-import "time"
+import (
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+)
 
 // Promo use for response Create, Update, Fetch Promos & Fetch By ID API
 type Promo struct {
-	Currency           string       `json:"currency"`
-	Label              string       `json:"label"`
-	Description        string       `json:"description"`
-	MinOrderAmount     string       `json:"min_order_amount"`
-	MaxDiscountAmount  string       `json:"max_discount_amount"`
-	StartsAt           time.Time    `json:"starts_at"`
-	EndsAt             time.Time    `json:"ends_at"`
-	Discount           string       `json:"discount"`
-	DiscountType       string       `json:"discount_type"`
-	Type               string       `json:"type"`
-	PromoDetails       PromoDetails `json:"promo_details"`
-	SubType            string       `json:"sub_type"`
-	LimitType          string       `json:"limit_type"`
-	LimitValue         string       `json:"limit_value"`
-	PriceDeductionType string       `json:"price_deduction_type"`
-	Status             string       `json:"status"`
-	CreatedAt          time.Time    `json:"created_at"`
-	UpdatedAt          time.Time    `json:"updated_at"`
-	IsLive             bool         `json:"is_live"`
-	PromoUsage         string       `json:"promo_usage"`
-	ID                 string       `json:"id"`
+	Currency           string                            `json:"currency"`
+	Label              string                            `json:"label"`
+	Description        string                            `json:"description"`
+	MinOrderAmount     durianpay.Amount                  `json:"min_order_amount"`
+	MaxDiscountAmount  durianpay.Amount                  `json:"max_discount_amount"`
+	StartsAt           time.Time                         `json:"starts_at"`
+	EndsAt             time.Time                         `json:"ends_at"`
+	Discount           string                            `json:"discount"`
+	DiscountType       durianpay.PromoDiscountType       `json:"discount_type"`
+	Type               durianpay.PromoType               `json:"type"`
+	PromoDetails       PromoDetails                      `json:"promo_details"`
+	SubType            durianpay.PromoSubType            `json:"sub_type"`
+	LimitType          durianpay.PromoLimitType          `json:"limit_type"`
+	LimitValue         string                            `json:"limit_value"`
+	PriceDeductionType durianpay.PromoPriceDeductionType `json:"price_deduction_type"`
+	Status             string                            `json:"status"`
+	CreatedAt          time.Time                         `json:"created_at"`
+	UpdatedAt          time.Time                         `json:"updated_at"`
+	IsLive             bool                              `json:"is_live"`
+	PromoUsage         string                            `json:"promo_usage"`
+	ID                 string                            `json:"id"`
 }
 
 // PromoDetails is part of Promo
@@ -0,0 +1,121 @@
+/*
+ * File Created: Monday, 19th October 2026 12:52:19 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxPercentage is 100% in minor units of Amount, used to parse percentage Discount with ParseAmount.
+const maxPercentage = 100 * 100
+
+// ValidatePromo checks payload of Create & Update Promo API, in addition to `validate` tags:
+//
+//   - Percentage Discount is greater than 0 and at most 100
+//   - StartsAt is before EndsAt
+//   - BIN of PromoDetails.BinList has 6 or 8 digits, BIN with leading zero cannot be represented by int and is rejected
+//   - PromoDetails.BankCodes are not empty, card promo needs BIN list or bank codes, VA & e-wallet promo need bank codes
+//   - Code is present for discount code promo, LimitValue is present & numeric when LimitType is set
+//
+// Validity of bank codes is left to the API, use bank.Catalog to check them before. Validate calls ValidatePromo
+// for PromoPayload. It returns nil if payload is valid, otherwise Error with ErrorCodeSDKValidation and every invalid field in Errors.
+func ValidatePromo(payload PromoPayload) *Error {
+	errs := []Errors{}
+	if err := validateTags(payload); err != nil {
+		if len(err.Errors) == 0 {
+			return err
+		}
+		errs = append(errs, err.Errors...)
+	}
+
+	if payload.DiscountType == PromoDiscountPercentage {
+		if discount, err := ParseAmount(payload.Discount); err == nil && discount.MinorUnits() > maxPercentage {
+			errs = append(errs, Errors{Field: "discount", Message: "discount must be at most 100 for percentage discount"})
+		}
+	}
+
+	if !payload.StartsAt.IsZero() && !payload.EndsAt.IsZero() && !payload.StartsAt.Before(payload.EndsAt) {
+		errs = append(errs, Errors{Field: "ends_at", Message: "ends_at must be after starts_at"})
+	}
+
+	for i, bin := range payload.PromoDetails.BinList {
+		if !(bin >= 100000 && bin <= 999999) && !(bin >= 10000000 && bin <= 99999999) {
+			errs = append(errs, Errors{Field: fmt.Sprintf("promo_details.bin_list[%d]", i), Message: "bin_list must have 6 or 8 digits"})
+		}
+	}
+
+	for i, code := range payload.PromoDetails.BankCodes {
+		if strings.TrimSpace(code) == "" {
+			errs = append(errs, Errors{Field: fmt.Sprintf("promo_details.bank_codes[%d]", i), Message: "bank_codes must not be empty"})
+		}
+	}
+
+	switch payload.Type {
+	case PromoTypeCard:
+		if len(payload.PromoDetails.BinList) == 0 && len(payload.PromoDetails.BankCodes) == 0 {
+			errs = append(errs, Errors{Field: "promo_details", Message: "promo_details must have bin_list or bank_codes for card_promos"})
+		}
+	case PromoTypeVA, PromoTypeEwallet:
+		if len(payload.PromoDetails.BankCodes) == 0 {
+			errs = append(errs, Errors{Field: "promo_details.bank_codes", Message: fmt.Sprintf("bank_codes is required for %s", payload.Type)})
+		}
+	}
+
+	if payload.SubType == PromoSubTypeDiscountCode && payload.Code == "" {
+		errs = append(errs, Errors{Field: "code", Message: "code is required for discount_code"})
+	}
+
+	if payload.LimitType != "" {
+		if payload.LimitValue == "" {
+			errs = append(errs, Errors{Field: "limit_value", Message: "limit_value is required when limit_type is set"})
+		} else if _, err := ParseAmount(payload.LimitValue); err != nil || (payload.LimitType == PromoLimitQuota && !isDigits(payload.LimitValue)) {
+			errs = append(errs, Errors{Field: "limit_value", Message: fmt.Sprintf("limit_value must be a valid %s", payload.LimitType)})
+		}
+	}
+
+	return FromValidationErrors("invalid promo", errs)
+}
+
+// PromoSimulation is result of SimulatePromo.
+type PromoSimulation struct {
+	Eligible bool   // False when the order amount is less than MinOrderAmount
+	Discount Amount // Discount after MaxDiscountAmount, never more than the order amount
+	Total    Amount // Order amount after discount
+}
+
+// SimulatePromo returns discount of payload for an order amount, so the promo can be checked before it is created.
+// Percentage discount is rounded half up to 2 decimals and capped at MaxDiscountAmount when it is set.
+// Payload should be valid, see ValidatePromo, invalid Discount gives zero discount.
+func SimulatePromo(payload PromoPayload, orderAmount Amount) PromoSimulation {
+	if orderAmount.LessThan(payload.MinOrderAmount) || !orderAmount.GreaterThan(Amount{}) {
+		return PromoSimulation{Total: orderAmount}
+	}
+
+	value, _ := ParseAmount(payload.Discount)
+
+	var discount Amount
+	switch payload.DiscountType {
+	case PromoDiscountPercentage:
+		discount = orderAmount.MulRatio(value.MinorUnits(), maxPercentage)
+		if !payload.MaxDiscountAmount.IsZero() && discount.GreaterThan(payload.MaxDiscountAmount) {
+			discount = payload.MaxDiscountAmount
+		}
+	case PromoDiscountFlat:
+		discount = value
+	}
+
+	if discount.GreaterThan(orderAmount) {
+		discount = orderAmount
+	}
+
+	return PromoSimulation{
+		Eligible: true,
+		Discount: discount,
+		Total:    orderAmount.Sub(discount),
+	}
+}
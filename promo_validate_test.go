@@ -0,0 +1,171 @@
+/*
+ * File Created: Monday, 19th October 2026 12:52:19 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestValidatePromo(t *testing.T) {
+	startsAt := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+
+	valid := PromoPayload{
+		Type:               PromoTypeCard,
+		Label:              "SALE2026",
+		Currency:           "IDR",
+		PromoDetails:       PromoDetails{BinList: []int{424242, 52345678}, BankCodes: []string{BankBCA}},
+		DiscountType:       PromoDiscountPercentage,
+		Discount:           "12.5",
+		StartsAt:           startsAt,
+		EndsAt:             startsAt.Add(72 * time.Hour),
+		SubType:            PromoSubTypeDirectDiscount,
+		LimitType:          PromoLimitQuota,
+		LimitValue:         "100",
+		PriceDeductionType: PromoDeductTotalPrice,
+	}
+
+	tests := []struct {
+		name       string
+		payload    func(p PromoPayload) PromoPayload
+		wantErrors []Errors
+	}{
+		{
+			name:    "Valid",
+			payload: func(p PromoPayload) PromoPayload { return p },
+		},
+		{
+			name: "Percentage, period & BIN",
+			payload: func(p PromoPayload) PromoPayload {
+				p.Discount = "100.01"
+				p.EndsAt = p.StartsAt
+				p.PromoDetails.BinList = []int{42424, 4242424, -424242, 100000000}
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "discount", Message: "discount must be at most 100 for percentage discount"},
+				{Field: "ends_at", Message: "ends_at must be after starts_at"},
+				{Field: "promo_details.bin_list[0]", Message: "bin_list must have 6 or 8 digits"},
+				{Field: "promo_details.bin_list[1]", Message: "bin_list must have 6 or 8 digits"},
+				{Field: "promo_details.bin_list[2]", Message: "bin_list must have 6 or 8 digits"},
+				{Field: "promo_details.bin_list[3]", Message: "bin_list must have 6 or 8 digits"},
+			},
+		},
+		{
+			name: "Bank codes of VA promo, validity is left to the API",
+			payload: func(p PromoPayload) PromoPayload {
+				p.Type = PromoTypeVA
+				p.PromoDetails = PromoDetails{BankCodes: []string{"NEWBANK", " "}}
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "promo_details.bank_codes[1]", Message: "bank_codes must not be empty"},
+			},
+		},
+		{
+			name: "Required per type",
+			payload: func(p PromoPayload) PromoPayload {
+				p.Type = PromoTypeEwallet
+				p.PromoDetails = PromoDetails{}
+				p.SubType = PromoSubTypeDiscountCode
+				p.LimitType = PromoLimitBudget
+				p.LimitValue = ""
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "promo_details.bank_codes", Message: "bank_codes is required for ewallet_promos"},
+				{Field: "code", Message: "code is required for discount_code"},
+				{Field: "limit_value", Message: "limit_value is required when limit_type is set"},
+			},
+		},
+		{
+			name: "Card promo without filter & invalid quota",
+			payload: func(p PromoPayload) PromoPayload {
+				p.PromoDetails = PromoDetails{}
+				p.LimitValue = "10.5"
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "promo_details", Message: "promo_details must have bin_list or bank_codes for card_promos"},
+				{Field: "limit_value", Message: "limit_value must be a valid quota"},
+			},
+		},
+		{
+			name: "Enums from validate tags",
+			payload: func(p PromoPayload) PromoPayload {
+				p.DiscountType = "fixed"
+				return p
+			},
+			wantErrors: []Errors{
+				{Field: "discount_type", Message: "discount_type must be one of percentage, flat"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidatePromo(tt.payload(valid))
+			if tt.wantErrors == nil {
+				if got != nil {
+					t.Errorf("ValidatePromo() = %v, want nil", got.Errors)
+				}
+				return
+			}
+
+			if got == nil || got.ErrorCode != ErrorCodeSDKValidation {
+				t.Fatalf("ValidatePromo() = %v, want validation error", got)
+			}
+
+			if !reflect.DeepEqual(got.Errors, tt.wantErrors) {
+				t.Errorf("ValidatePromo() Errors = %v, want %v", got.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestSimulatePromo(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload PromoPayload
+		amount  Amount
+		want    PromoSimulation
+	}{
+		{
+			name:    "Percentage",
+			payload: PromoPayload{DiscountType: PromoDiscountPercentage, Discount: "12.5"},
+			amount:  MustParseAmount("10001"),
+			want:    PromoSimulation{Eligible: true, Discount: MustParseAmount("1250.13"), Total: MustParseAmount("8750.87")},
+		},
+		{
+			name:    "Percentage capped",
+			payload: PromoPayload{DiscountType: PromoDiscountPercentage, Discount: "50", MaxDiscountAmount: NewAmount(20000)},
+			amount:  NewAmount(100000),
+			want:    PromoSimulation{Eligible: true, Discount: NewAmount(20000), Total: NewAmount(80000)},
+		},
+		{
+			name:    "Flat more than amount",
+			payload: PromoPayload{DiscountType: PromoDiscountFlat, Discount: "15000"},
+			amount:  NewAmount(10000),
+			want:    PromoSimulation{Eligible: true, Discount: NewAmount(10000), Total: Amount{}},
+		},
+		{
+			name:    "Below minimum order",
+			payload: PromoPayload{DiscountType: PromoDiscountFlat, Discount: "5000", MinOrderAmount: NewAmount(50000)},
+			amount:  NewAmount(49999),
+			want:    PromoSimulation{Total: NewAmount(49999)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := SimulatePromo(tt.payload, tt.amount); got != tt.want {
+				t.Errorf("SimulatePromo() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
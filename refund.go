@@ -12,9 +12,9 @@ Payloads
 
 // RefundPayload is struct for payload Create Refund API
 type RefundPayload struct {
-	RefID         string `json:"ref_id"`
-	PaymentID     string `json:"payment_id"`
-	Amount        string `json:"amount"`
+	RefID         string `json:"ref_id" validate:"required"`
+	PaymentID     string `json:"payment_id" validate:"required"`
+	Amount        Amount `json:"amount" validate:"required,amount"`
 	UseRefundLink bool   `json:"use_refund_link"`
 	Notes         string `json:"notes"`
 }
@@ -25,8 +25,7 @@ Options
 
 // RefundFetchOption is parameter for Refund Fetch API
 type RefundFetchOption struct {
-	From  string `url:"from"`
-	To    string `url:"to"`
-	Skip  uint16 `url:"skip"`
-	Limit uint16 `url:"limit"`
+	Range DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip  uint16    `url:"skip"`
+	Limit uint16    `url:"limit"`
 }
@@ -57,6 +57,30 @@ func (c *Client) FetchRefunds(ctx context.Context, opt durianpay.RefundFetchOpti
 	return &res.Data, nil
 }
 
+// IterateRefunds returns iterator over every refund of Refunds Fetch API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateRefunds(ctx context.Context, opt durianpay.RefundFetchOption) *common.Iterator[Refunds] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[Refunds] {
+		opt := opt
+		opt.Range = window
+
+		return func(ctx context.Context, skip, limit int) (common.Page[Refunds], *durianpay.Error) {
+			var err *durianpay.Error
+			if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+				return common.Page[Refunds]{}, err
+			}
+
+			res, err := c.FetchRefunds(ctx, opt)
+			if err != nil {
+				return common.Page[Refunds]{}, err
+			}
+
+			return common.Page[Refunds]{Items: res.Refunds, Total: int(res.TotalData)}, nil
+		}
+	})
+}
+
 // FetchRefundByID return a response from Refund Fetch By ID API.
 //
 //	[Doc Refund Fetch By ID API]: https://durianpay.id/docs/api/refunds/fetch-one/
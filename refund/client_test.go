@@ -51,7 +51,7 @@ func TestClient_Create(t *testing.T) {
 				payload: durianpay.RefundPayload{
 					RefID:         "order_ref_241",
 					PaymentID:     "pay_y2yKEEWBYe1299",
-					Amount:        "10000",
+					Amount:        durianpay.NewAmount(10000),
 					UseRefundLink: false,
 					Notes:         "rejected product",
 				},
@@ -70,7 +70,7 @@ func TestClient_Create(t *testing.T) {
 			wantRes: &Refund{
 				ID:            "rfn_iLNvzkCakx0330",
 				RefID:         "order_ref_241",
-				Amount:        "10000.00",
+				Amount:        durianpay.MustParseAmount("10000.00"),
 				RefundType:    "full",
 				Status:        "done",
 				CreatedAt:     tests.StringToTime("2023-08-30T16:54:39.593123Z"),
@@ -162,7 +162,7 @@ func TestClient_FetchRefunds(t *testing.T) {
 						ID:                 "rfn_iLNvzkCakx0330",
 						MerchantID:         "mer_pHXgBZ2Qx95625",
 						Status:             "done",
-						TotalAmount:        "10000.00",
+						TotalAmount:        durianpay.MustParseAmount("10000.00"),
 						CreatedAt:          tests.StringToTime("2023-08-30T16:54:39.593123Z"),
 						UpdatedAt:          tests.StringToTime("2023-08-30T16:54:39.593123Z"),
 						PaymentID:          "pay_y2yKEEWBYe1299",
@@ -170,7 +170,7 @@ func TestClient_FetchRefunds(t *testing.T) {
 						Type:               "normal",
 						CustomerID:         "cus_IwDIb0MDY20938",
 						RefundPartial:      "full",
-						PaymentPaidAmount:  "10000.00",
+						PaymentPaidAmount:  durianpay.MustParseAmount("10000.00"),
 						PaymentDetailsType: "va_details",
 						PaymentMethodID:    "BCA",
 						CustomerName:       "Abdul",
@@ -257,7 +257,7 @@ func TestClient_FetchRefundByID(t *testing.T) {
 			wantRes: &Refund{
 				ID:            "rfn_iLNvzkCakx0330",
 				RefID:         "order_ref_241",
-				Amount:        "10000.00",
+				Amount:        durianpay.MustParseAmount("10000.00"),
 				RefundType:    "full",
 				Status:        "done",
 				CreatedAt:     tests.StringToTime("2023-08-30T16:54:39.593123Z"),
@@ -305,3 +305,44 @@ func TestClient_FetchRefundByID(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_IterateRefunds(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	// Both pages are full, only TotalData stops the iterator before the third request
+	pages := map[uint16]FetchRefunds{
+		0: {Refunds: []Refunds{{ID: "rfn_1"}, {ID: "rfn_2"}}, TotalData: 4},
+		2: {Refunds: []Refunds{{ID: "rfn_3"}, {ID: "rfn_4"}}, TotalData: 4},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.RefundFetchOption{Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", pathRefund, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				response.(*struct {
+					Data FetchRefunds `json:"data"`
+				}).Data = page
+				return nil
+			})
+	}
+
+	it := c.IterateRefunds(context.Background(), durianpay.RefundFetchOption{Limit: 2})
+
+	gotIDs := []string{}
+	for it.Next() {
+		gotIDs = append(gotIDs, it.Item().ID)
+	}
+
+	if it.Err() != nil || !reflect.DeepEqual(gotIDs, []string{"rfn_1", "rfn_2", "rfn_3", "rfn_4"}) || it.Page().Total != 4 {
+		t.Errorf("Client.IterateRefunds() = %v, total %v, %v", gotIDs, it.Page().Total, it.Err())
+	}
+}
@@ -16,8 +16,7 @@ Options
 
 // SettlementOption is parameter for Fetch and Details API.
 type SettlementOption struct {
-	From  string `url:"from"`
-	To    string `url:"to"`
-	Skip  uint16 `url:"skip"`
-	Limit uint16 `url:"limit"`
+	Range DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip  uint16    `url:"skip"`
+	Limit uint16    `url:"limit"`
 }
@@ -40,6 +40,42 @@ func (c *Client) FetchSettlements(ctx context.Context, opt durianpay.SettlementO
 	return &res, nil
 }
 
+// IterateSettlements returns iterator over every settlement of Settlements Fetch API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateSettlements(ctx context.Context, opt durianpay.SettlementOption) *common.Iterator[Settlement] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[Settlement] {
+		opt.Range = window
+		return c.settlementPages(opt)
+	})
+}
+
+// BulkSettlements returns fetcher of every settlement of Settlements Fetch API, pages after the first are fetched concurrently.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) BulkSettlements(opt durianpay.SettlementOption) *common.BulkFetcher[Settlement] {
+	return &common.BulkFetcher[Settlement]{Fetch: c.settlementPages(opt), Skip: int(opt.Skip), Limit: int(opt.Limit)}
+}
+
+// settlementPages returns page fetcher of FetchSettlements for the given filters of opt.
+func (c *Client) settlementPages(opt durianpay.SettlementOption) common.PageFetcher[Settlement] {
+	return func(ctx context.Context, skip, limit int) (common.Page[Settlement], *durianpay.Error) {
+		// Copy of opt, pages may be fetched concurrently by common.BulkFetcher
+		opt := opt
+
+		var err *durianpay.Error
+		if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+			return common.Page[Settlement]{}, err
+		}
+
+		res, err := c.FetchSettlements(ctx, opt)
+		if err != nil {
+			return common.Page[Settlement]{}, err
+		}
+
+		return common.Page[Settlement]{Items: res.SettlementDetail, Total: int(res.TotalCount)}, nil
+	}
+}
+
 // FetchDetails return a response from Settlements Details Fetch API.
 //
 //	[Doc Settlements Details Fetch API]: https://durianpay.id/docs/api/settlements/settlements-fetch-details/
@@ -54,6 +90,43 @@ func (c *Client) FetchDetails(ctx context.Context, opt durianpay.SettlementOptio
 	return &res, nil
 }
 
+// IterateDetails returns iterator over every settlement detail of Settlements Details Fetch API, it fetches the next page when needed.
+// Total of the page is TransactionCount.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateDetails(ctx context.Context, opt durianpay.SettlementOption) *common.Iterator[SettlementDetail] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[SettlementDetail] {
+		opt.Range = window
+		return c.detailPages(opt)
+	})
+}
+
+// BulkDetails returns fetcher of every settlement detail of Settlements Details Fetch API, pages after the first are fetched concurrently.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) BulkDetails(opt durianpay.SettlementOption) *common.BulkFetcher[SettlementDetail] {
+	return &common.BulkFetcher[SettlementDetail]{Fetch: c.detailPages(opt), Skip: int(opt.Skip), Limit: int(opt.Limit)}
+}
+
+// detailPages returns page fetcher of FetchDetails for the given filters of opt.
+func (c *Client) detailPages(opt durianpay.SettlementOption) common.PageFetcher[SettlementDetail] {
+	return func(ctx context.Context, skip, limit int) (common.Page[SettlementDetail], *durianpay.Error) {
+		// Copy of opt, pages may be fetched concurrently by common.BulkFetcher
+		opt := opt
+
+		var err *durianpay.Error
+		if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+			return common.Page[SettlementDetail]{}, err
+		}
+
+		res, err := c.FetchDetails(ctx, opt)
+		if err != nil {
+			return common.Page[SettlementDetail]{}, err
+		}
+
+		return common.Page[SettlementDetail]{Items: res.SettlementDetail, Total: int(res.TransactionCount)}, nil
+	}
+}
+
 // StatusByPaymentID return a response from Settlements Status By Payment ID API.
 //
 //	[Doc Settlements Status By Payment ID API]: https://durianpay.id/docs/api/settlements/settlements-fetch-by-payment-id/
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/common"
 	"github.com/abmid/dpay-sdk-go/internal/tests"
 	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
 	"github.com/golang/mock/gomock"
@@ -50,8 +51,7 @@ func TestClient_FetchSettlements(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.SettlementOption{
-					From: time.Now().Format("2006-01-02"),
-					To:   time.Now().Format("2006-01-02"),
+					Range: durianpay.Today(),
 				},
 			},
 			prepare: func(m mocks, args args) {
@@ -71,10 +71,10 @@ func TestClient_FetchSettlements(t *testing.T) {
 				SettlementDetail: []Settlement{
 					{
 						ID:                     "set_WDizQUoyWy1234",
-						SettlementAmount:       "20000.00",
+						SettlementAmount:       durianpay.MustParseAmount("20000.00"),
 						Status:                 "settled",
-						Fee:                    "200.00",
-						TotalTransactionAmount: "20200.00",
+						Fee:                    durianpay.MustParseAmount("200.00"),
+						TotalTransactionAmount: durianpay.MustParseAmount("20200.00"),
 						CreatedAt:              tests.StringToTime("2021-05-17T08:30:56.73529Z"),
 						SettledAt:              tests.StringToTime("2021-05-17T08:32:00.628182Z"),
 						Currency:               "IDR",
@@ -138,8 +138,7 @@ func TestClient_FetchDetails(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.SettlementOption{
-					From: time.Now().Format("2006-01-02"),
-					To:   time.Now().Format("2006-01-02"),
+					Range: durianpay.Today(),
 				},
 			},
 			prepare: func(m mocks, args args) {
@@ -165,12 +164,12 @@ func TestClient_FetchDetails(t *testing.T) {
 						OrderReference:     "order_ref_001",
 						Status:             "settled",
 						Currency:           "IDR",
-						SettlementAmount:   "19635.00",
-						TotalSettlementFee: "365.00",
+						SettlementAmount:   durianpay.MustParseAmount("19635.00"),
+						TotalSettlementFee: durianpay.MustParseAmount("365.00"),
 						SettledAt:          tests.StringToTime("2021-05-17T08:32:00.628182Z"),
-						PaymentAmount:      "20000.00",
+						PaymentAmount:      durianpay.MustParseAmount("20000.00"),
 						PaymentDate:        tests.StringToTime("2021-05-17T08:26:43.990125Z"),
-						TransactionAmount:  "20000.00",
+						TransactionAmount:  durianpay.MustParseAmount("20000.00"),
 						PaymentDetailsType: "ewallet_details",
 						PaymentMethodID:    "SHOPEEPAY",
 					},
@@ -213,6 +212,47 @@ func TestClient_FetchDetails(t *testing.T) {
 	}
 }
 
+func TestClient_BulkDetails(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	pages := map[uint16]FetchDetails{
+		0: {SettlementDetail: []SettlementDetail{{PaymentID: "pay_1"}, {PaymentID: "pay_2"}}, TransactionCount: 5},
+		2: {SettlementDetail: []SettlementDetail{{PaymentID: "pay_3"}, {PaymentID: "pay_4"}}, TransactionCount: 5},
+		4: {SettlementDetail: []SettlementDetail{{PaymentID: "pay_5"}}, TransactionCount: 5},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.SettlementOption{Range: durianpay.Month(2023, time.September), Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", pathDetail, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				*response.(*FetchDetails) = page
+				return nil
+			})
+	}
+
+	b := c.BulkDetails(durianpay.SettlementOption{Range: durianpay.Month(2023, time.September), Limit: 2})
+	state := &common.BulkState[SettlementDetail]{}
+	err := b.Run(context.Background(), state)
+
+	gotIDs := []string{}
+	for _, detail := range state.Items {
+		gotIDs = append(gotIDs, detail.PaymentID)
+	}
+
+	if err != nil || !state.Done() || !reflect.DeepEqual(gotIDs, []string{"pay_1", "pay_2", "pay_3", "pay_4", "pay_5"}) {
+		t.Errorf("Client.BulkDetails() = %v, %v", gotIDs, err)
+	}
+}
+
 func TestClient_StatusByPaymentID(t *testing.T) {
 	featureWrap := tests.FeatureWrap(t)
 	defer featureWrap.Ctrl.Finish()
@@ -257,13 +297,13 @@ func TestClient_StatusByPaymentID(t *testing.T) {
 				OrderReference:     "order_ref_001",
 				Status:             "settled",
 				Currency:           "IDR",
-				SettlementAmount:   "19635.00",
-				TotalSettlementFee: "365.00",
+				SettlementAmount:   durianpay.MustParseAmount("19635.00"),
+				TotalSettlementFee: durianpay.MustParseAmount("365.00"),
 				SettledAt:          tests.StringToTime("2021-05-17T08:32:00.628182Z"),
 				Group:              "A",
-				PaymentAmount:      "20000.00",
+				PaymentAmount:      durianpay.MustParseAmount("20000.00"),
 				PaymentDate:        tests.StringToTime("2021-05-17T08:26:43.990125Z"),
-				TransactionAmount:  "20000.00",
+				TransactionAmount:  durianpay.MustParseAmount("20000.00"),
 				PaymentChannel:     "ewallet_details",
 				PaymentSubchannel:  "SHOPEEPAY",
 			},
@@ -341,12 +381,12 @@ func TestClient_FetchSettlementByID(t *testing.T) {
 			},
 			wantRes: &Settlement{
 				ID:               "set_WDizQUoyWy8680",
-				SettlementAmount: "50000",
+				SettlementAmount: durianpay.NewAmount(50000),
 				Status:           "settled",
-				Fee:              "10000",
+				Fee:              durianpay.NewAmount(10000),
 				CreatedAt:        tests.StringToTime("2021-05-17T08:32:00.628182Z"),
 				SettledAt:        tests.StringToTime("2021-05-27T08:32:00.628182Z"),
-				PromoAmount:      "10000",
+				PromoAmount:      durianpay.NewAmount(10000),
 			},
 		},
 		{
@@ -387,3 +427,70 @@ func TestClient_FetchSettlementByID(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_IterateSettlements(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	// Both pages are full, only TotalCount stops the iterator before the third request
+	pages := map[uint16]FetchSettlements{
+		0: {SettlementDetail: []Settlement{{ID: "stl_1"}, {ID: "stl_2"}}, TotalCount: 4},
+		2: {SettlementDetail: []Settlement{{ID: "stl_3"}, {ID: "stl_4"}}, TotalCount: 4},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.SettlementOption{Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", pathSettlement, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				*response.(*FetchSettlements) = page
+				return nil
+			})
+	}
+
+	it := c.IterateSettlements(context.Background(), durianpay.SettlementOption{Limit: 2})
+
+	gotIDs := []string{}
+	for it.Next() {
+		gotIDs = append(gotIDs, it.Item().ID)
+	}
+
+	if it.Err() != nil || !reflect.DeepEqual(gotIDs, []string{"stl_1", "stl_2", "stl_3", "stl_4"}) || it.Page().Total != 4 {
+		t.Errorf("Client.IterateSettlements() = %v, total %v, %v", gotIDs, it.Page().Total, it.Err())
+	}
+}
+
+func TestClient_IterateDetails(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	// The page is full, only TransactionCount (not SettlementCount) stops the iterator before the second request
+	apiMock.EXPECT().
+		Req(gomock.Any(), "GET", pathDetail, durianpay.SettlementOption{Limit: 2}, nil, nil, gomock.Any()).
+		DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+			*response.(*FetchDetails) = FetchDetails{
+				SettlementDetail: []SettlementDetail{{PaymentID: "pay_1"}, {PaymentID: "pay_2"}},
+				SettlementCount:  1,
+				TransactionCount: 2,
+			}
+			return nil
+		})
+
+	items, err := c.IterateDetails(context.Background(), durianpay.SettlementOption{Limit: 2}).All()
+	if err != nil || len(items) != 2 {
+		t.Errorf("Client.IterateDetails() = %v, %v", items, err)
+	}
+}
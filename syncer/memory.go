@@ -0,0 +1,106 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package syncer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// MemorySink is in-memory Sink, records are compared by their JSON to detect changes.
+type MemorySink struct {
+	mu      sync.Mutex
+	records map[Kind]map[string]storedRecord
+}
+
+type storedRecord struct {
+	Record
+	data []byte
+}
+
+// NewMemorySink returns empty MemorySink.
+func NewMemorySink() *MemorySink {
+	return &MemorySink{records: map[Kind]map[string]storedRecord{}}
+}
+
+func (s *MemorySink) Upsert(ctx context.Context, records []Record) (UpsertResult, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := UpsertResult{}
+	for _, r := range records {
+		data, err := json.Marshal(r.Data)
+		if err != nil {
+			return res, err
+		}
+
+		if s.records[r.Kind] == nil {
+			s.records[r.Kind] = map[string]storedRecord{}
+		}
+
+		old, ok := s.records[r.Kind][r.ID]
+		switch {
+		case !ok:
+			res.Inserted++
+		case bytes.Equal(old.data, data):
+			res.Unchanged++
+			continue
+		default:
+			res.Updated++
+		}
+
+		s.records[r.Kind][r.ID] = storedRecord{Record: r, data: data}
+	}
+
+	return res, nil
+}
+
+// Get returns record of kind by ID.
+func (s *MemorySink) Get(kind Kind, ID string) (Record, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.records[kind][ID]
+
+	return r.Record, ok
+}
+
+// Len returns number of records of kind.
+func (s *MemorySink) Len(kind Kind) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.records[kind])
+}
+
+// MemoryCheckpointStore is in-memory CheckpointStore.
+type MemoryCheckpointStore struct {
+	mu          sync.Mutex
+	checkpoints map[Kind]time.Time
+}
+
+func (s *MemoryCheckpointStore) Load(ctx context.Context, kind Kind) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.checkpoints[kind], nil
+}
+
+func (s *MemoryCheckpointStore) Save(ctx context.Context, kind Kind, checkpoint time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.checkpoints == nil {
+		s.checkpoints = map[Kind]time.Time{}
+	}
+	s.checkpoints[kind] = checkpoint
+
+	return nil
+}
@@ -0,0 +1,192 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultRecordTable     = "durianpay_records"
+	DefaultCheckpointTable = "durianpay_sync_checkpoints"
+)
+
+// DollarPlaceholder returns placeholder $n of PostgreSQL, see SQLStore.Placeholder.
+func DollarPlaceholder(n int) string {
+	return "$" + strconv.Itoa(n)
+}
+
+// SQLStore is Sink & CheckpointStore of database/sql, it uses only portable SQL so it works with any driver.
+// Record is stored as JSON of Data with its kind, ID, status & update time, see CreateTables for the schema.
+type SQLStore struct {
+	DB              *sql.DB
+	RecordTable     string             // Default DefaultRecordTable
+	CheckpointTable string             // Default DefaultCheckpointTable
+	Placeholder     func(n int) string // Placeholder of n-th argument starts at 1, default ? of MySQL & SQLite
+}
+
+// CreateTables creates the tables when they do not exist.
+func (s *SQLStore) CreateTables(ctx context.Context) error {
+	stmts := []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	kind VARCHAR(32) NOT NULL,
+	id VARCHAR(64) NOT NULL,
+	status VARCHAR(32) NOT NULL,
+	updated_at TIMESTAMP NULL,
+	data TEXT NOT NULL,
+	synced_at TIMESTAMP NOT NULL,
+	PRIMARY KEY (kind, id)
+)`, s.recordTable()),
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS %s (
+	kind VARCHAR(32) NOT NULL PRIMARY KEY,
+	checkpoint VARCHAR(64) NOT NULL
+)`, s.checkpointTable()),
+	}
+
+	for _, stmt := range stmts {
+		if _, err := s.DB.ExecContext(ctx, stmt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Upsert stores records in a transaction, record with the same JSON is not updated.
+func (s *SQLStore) Upsert(ctx context.Context, records []Record) (UpsertResult, error) {
+	res, inserting, err := s.upsert(ctx, records)
+	if err == nil || !inserting {
+		return res, err
+	}
+
+	// Another writer inserted the record after the SELECT, the primary key rejects the INSERT.
+	// A failed statement aborts the transaction on some databases, so the whole transaction is retried once
+	// and the record is then found by the SELECT & updated.
+	res, _, err = s.upsert(ctx, records)
+
+	return res, err
+}
+
+// upsert runs one transaction of Upsert, inserting reports whether err is returned by INSERT of a record.
+func (s *SQLStore) upsert(ctx context.Context, records []Record) (res UpsertResult, inserting bool, err error) {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return res, false, err
+	}
+	defer tx.Rollback()
+
+	now := time.Now().UTC()
+	selectSQL := fmt.Sprintf("SELECT data FROM %s WHERE kind = %s AND id = %s", s.recordTable(), s.placeholder(1), s.placeholder(2))
+	insertSQL := fmt.Sprintf("INSERT INTO %s (kind, id, status, updated_at, data, synced_at) VALUES (%s, %s, %s, %s, %s, %s)",
+		s.recordTable(), s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4), s.placeholder(5), s.placeholder(6))
+	updateSQL := fmt.Sprintf("UPDATE %s SET status = %s, updated_at = %s, data = %s, synced_at = %s WHERE kind = %s AND id = %s",
+		s.recordTable(), s.placeholder(1), s.placeholder(2), s.placeholder(3), s.placeholder(4), s.placeholder(5), s.placeholder(6))
+
+	for _, r := range records {
+		data, err := json.Marshal(r.Data)
+		if err != nil {
+			return UpsertResult{}, false, err
+		}
+
+		updatedAt := sql.NullTime{Time: r.UpdatedAt.UTC(), Valid: !r.UpdatedAt.IsZero()}
+
+		var old string
+		err = tx.QueryRowContext(ctx, selectSQL, string(r.Kind), r.ID).Scan(&old)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+			if _, err := tx.ExecContext(ctx, insertSQL, string(r.Kind), r.ID, r.Status, updatedAt, string(data), now); err != nil {
+				return UpsertResult{}, true, err
+			}
+			res.Inserted++
+		case err != nil:
+			return UpsertResult{}, false, err
+		case old == string(data):
+			res.Unchanged++
+		default:
+			if _, err := tx.ExecContext(ctx, updateSQL, r.Status, updatedAt, string(data), now, string(r.Kind), r.ID); err != nil {
+				return UpsertResult{}, false, err
+			}
+			res.Updated++
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return UpsertResult{}, false, err
+	}
+
+	return res, false, nil
+}
+
+// Load returns checkpoint of kind, it is stored as RFC3339 text so every driver reads the same time.
+func (s *SQLStore) Load(ctx context.Context, kind Kind) (time.Time, error) {
+	var checkpoint string
+	err := s.DB.QueryRowContext(ctx, fmt.Sprintf("SELECT checkpoint FROM %s WHERE kind = %s", s.checkpointTable(), s.placeholder(1)), string(kind)).Scan(&checkpoint)
+	if errors.Is(err, sql.ErrNoRows) {
+		return time.Time{}, nil
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339Nano, checkpoint)
+}
+
+func (s *SQLStore) Save(ctx context.Context, kind Kind, checkpoint time.Time) error {
+	tx, err := s.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var old string
+	err = tx.QueryRowContext(ctx, fmt.Sprintf("SELECT checkpoint FROM %s WHERE kind = %s", s.checkpointTable(), s.placeholder(1)), string(kind)).Scan(&old)
+
+	value := checkpoint.UTC().Format(time.RFC3339Nano)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("INSERT INTO %s (kind, checkpoint) VALUES (%s, %s)", s.checkpointTable(), s.placeholder(1), s.placeholder(2)), string(kind), value)
+	case err == nil:
+		_, err = tx.ExecContext(ctx, fmt.Sprintf("UPDATE %s SET checkpoint = %s WHERE kind = %s", s.checkpointTable(), s.placeholder(1), s.placeholder(2)), value, string(kind))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (s *SQLStore) recordTable() string {
+	if s.RecordTable == "" {
+		return DefaultRecordTable
+	}
+
+	return s.RecordTable
+}
+
+func (s *SQLStore) checkpointTable() string {
+	if s.CheckpointTable == "" {
+		return DefaultCheckpointTable
+	}
+
+	return s.CheckpointTable
+}
+
+func (s *SQLStore) placeholder(n int) string {
+	if s.Placeholder == nil {
+		return "?"
+	}
+
+	return s.Placeholder(n)
+}
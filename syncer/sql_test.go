@@ -0,0 +1,175 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package syncer
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests/sqlfake"
+)
+
+// fakeSQLStore is database which understands only the statements of SQLStore.
+type fakeSQLStore struct {
+	records     map[string][]driver.Value // kind/id -> status, updated_at, data
+	checkpoints map[string]string
+	// beforeInsert is called before INSERT of a record is executed, ex: to insert the record from another writer
+	beforeInsert func(records map[string][]driver.Value) error
+}
+
+func openFakeSQLStore(t *testing.T) (*sql.DB, *sqlfake.DB, *fakeSQLStore) {
+	fake := &fakeSQLStore{records: map[string][]driver.Value{}, checkpoints: map[string]string{}}
+	d := &sqlfake.DB{Exec: fake.exec, Query: fake.query}
+
+	return sqlfake.Open(t, d), d, fake
+}
+
+func (f *fakeSQLStore) exec(query string, args []driver.Value) (driver.Result, error) {
+	switch {
+	case strings.HasPrefix(query, "INSERT INTO durianpay_records"):
+		if f.beforeInsert != nil {
+			if err := f.beforeInsert(f.records); err != nil {
+				return nil, err
+			}
+		}
+
+		key := args[0].(string) + "/" + args[1].(string)
+		if _, ok := f.records[key]; ok {
+			return nil, errors.New("UNIQUE constraint failed: kind, id")
+		}
+		f.records[key] = []driver.Value{args[2], args[3], args[4]}
+	case strings.HasPrefix(query, "UPDATE durianpay_records"):
+		f.records[args[4].(string)+"/"+args[5].(string)] = []driver.Value{args[0], args[1], args[2]}
+	case strings.HasPrefix(query, "INSERT INTO durianpay_sync_checkpoints"):
+		f.checkpoints[args[0].(string)] = args[1].(string)
+	case strings.HasPrefix(query, "UPDATE durianpay_sync_checkpoints"):
+		f.checkpoints[args[1].(string)] = args[0].(string)
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (f *fakeSQLStore) query(query string, args []driver.Value) ([]driver.Value, error) {
+	switch {
+	case strings.HasPrefix(query, "SELECT data FROM durianpay_records"):
+		if r, ok := f.records[args[0].(string)+"/"+args[1].(string)]; ok {
+			return []driver.Value{r[2]}, nil
+		}
+	case strings.HasPrefix(query, "SELECT checkpoint FROM durianpay_sync_checkpoints"):
+		if c, ok := f.checkpoints[args[0].(string)]; ok {
+			return []driver.Value{c}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func TestSQLStore_Upsert(t *testing.T) {
+	db, _, fake := openFakeSQLStore(t)
+	store := &SQLStore{DB: db}
+	ctx := context.Background()
+
+	if err := store.CreateTables(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	updatedAt := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	records := []Record{
+		{Kind: KindPayment, ID: "pay_1", Status: "processing", UpdatedAt: updatedAt, Data: map[string]string{"status": "processing"}},
+		{Kind: KindPayment, ID: "pay_2", Status: "completed", Data: map[string]string{"status": "completed"}},
+	}
+
+	res, err := store.Upsert(ctx, records)
+	if err != nil || res != (UpsertResult{Inserted: 2}) {
+		t.Fatalf("SQLStore.Upsert() = %+v, %v", res, err)
+	}
+
+	if got := fake.records["payment/pay_2"]; got[1] != nil || got[2] != `{"status":"completed"}` {
+		t.Errorf("SQLStore.Upsert() stored = %v, want null updated_at & JSON data", got)
+	}
+
+	records[0].Status, records[0].Data = "completed", map[string]string{"status": "completed"}
+	res, err = store.Upsert(ctx, records)
+	if err != nil || res != (UpsertResult{Updated: 1, Unchanged: 1}) {
+		t.Fatalf("SQLStore.Upsert() = %+v, %v", res, err)
+	}
+
+	if got := fake.records["payment/pay_1"]; got[0] != "completed" || !got[1].(time.Time).Equal(updatedAt) {
+		t.Errorf("SQLStore.Upsert() updated = %v", got)
+	}
+}
+
+func TestSQLStore_UpsertRace(t *testing.T) {
+	db, d, fake := openFakeSQLStore(t)
+	store := &SQLStore{DB: db}
+	ctx := context.Background()
+
+	// Another writer inserts the record between SELECT & INSERT
+	fake.beforeInsert = func(records map[string][]driver.Value) error {
+		records["payment/pay_1"] = []driver.Value{"processing", nil, `{"status":"processing"}`}
+		return nil
+	}
+
+	records := []Record{{Kind: KindPayment, ID: "pay_1", Status: "completed", Data: map[string]string{"status": "completed"}}}
+	res, err := store.Upsert(ctx, records)
+	if err != nil || res != (UpsertResult{Updated: 1}) {
+		t.Fatalf("SQLStore.Upsert() = %+v, %v, want updated after the record is inserted by another writer", res, err)
+	}
+
+	if got := fake.records["payment/pay_1"]; got[0] != "completed" {
+		t.Errorf("SQLStore.Upsert() stored = %v, want completed", got)
+	}
+
+	if want := []string{"SELECT", "INSERT", "SELECT", "UPDATE"}; !reflect.DeepEqual(d.Verbs(), want) {
+		t.Errorf("SQLStore.Upsert() statements = %v, want %v", d.Verbs(), want)
+	}
+
+	// INSERT error is returned when the record still does not exist
+	fake.beforeInsert = func(records map[string][]driver.Value) error {
+		return errors.New("disk full")
+	}
+	records[0].ID = "pay_2"
+	if _, err := store.Upsert(ctx, records); err == nil || err.Error() != "disk full" {
+		t.Errorf("SQLStore.Upsert() error = %v, want disk full", err)
+	}
+}
+
+func TestSQLStore_Checkpoint(t *testing.T) {
+	db, d, _ := openFakeSQLStore(t)
+	store := &SQLStore{DB: db, Placeholder: DollarPlaceholder}
+	ctx := context.Background()
+
+	got, err := store.Load(ctx, KindOrder)
+	if err != nil || !got.IsZero() {
+		t.Fatalf("SQLStore.Load() = %v, %v, want zero time", got, err)
+	}
+
+	for _, checkpoint := range []time.Time{
+		time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC),
+		time.Date(2023, 9, 6, 17, 30, 0, 0, time.FixedZone("WIB", 7*60*60)),
+	} {
+		if err := store.Save(ctx, KindOrder, checkpoint); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := store.Load(ctx, KindOrder)
+		if err != nil || !got.Equal(checkpoint) {
+			t.Errorf("SQLStore.Load() = %v, %v, want %v", got, err, checkpoint)
+		}
+	}
+
+	want := "UPDATE durianpay_sync_checkpoints SET checkpoint = $1 WHERE kind = $2"
+	if last := d.Statements[len(d.Statements)-2]; last != want {
+		t.Errorf("SQLStore.Save() statement = %q, want %q", last, want)
+	}
+}
@@ -0,0 +1,298 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/common"
+	"github.com/abmid/dpay-sdk-go/order"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+	"github.com/abmid/dpay-sdk-go/virtualaccount"
+)
+
+const (
+	// DefaultOverlap is Syncer.Overlap when it is zero.
+	DefaultOverlap = 24 * time.Hour
+	// DefaultInitialLookback is Syncer.InitialLookback when it is zero.
+	DefaultInitialLookback = 30 * 24 * time.Hour
+)
+
+// Kind is type of synced record.
+type Kind string
+
+const (
+	KindOrder          Kind = "order"
+	KindPayment        Kind = "payment"
+	KindRefund         Kind = "refund"
+	KindVirtualAccount Kind = "virtual_account"
+)
+
+// Record is an object of list API passed to Sink.
+type Record struct {
+	Kind      Kind
+	ID        string
+	Status    string
+	UpdatedAt time.Time // CreatedAt when the API has no update time, ex: Virtual Account
+	Data      any       // order.Orders, payment.Payments, refund.Refunds or virtualaccount.VirtualAccount
+}
+
+// UpsertResult is number of records per outcome of Sink.Upsert.
+type UpsertResult struct {
+	Inserted  int
+	Updated   int
+	Unchanged int
+}
+
+// Sink stores records, Upsert must be idempotent because the overlap window passes the same records again.
+type Sink interface {
+	Upsert(ctx context.Context, records []Record) (UpsertResult, error)
+}
+
+// CheckpointStore persists the time of the last successful sync per kind.
+type CheckpointStore interface {
+	Load(ctx context.Context, kind Kind) (time.Time, error) // Returns zero time if there is no checkpoint
+	Save(ctx context.Context, kind Kind, checkpoint time.Time) error
+}
+
+// KindStats is statistics of a kind in a Syncer run.
+type KindStats struct {
+	Range      durianpay.DateRange // Dates requested from the list API
+	Fetched    int                 // Records returned by the list API
+	Duplicates int                 // Records returned more than once, not passed to Sink again
+	UpsertResult
+	Checkpoint time.Time // Saved checkpoint, zero when the kind failed
+	Err        error
+}
+
+// Stats is statistics of a Syncer run.
+type Stats struct {
+	Started  time.Time
+	Duration time.Duration
+	Kinds    map[Kind]*KindStats
+}
+
+// Syncer mirrors orders, payments, refunds and virtual accounts into Sink.
+//
+// Each run pulls records through the list APIs since the checkpoint of the kind and upserts them page by page.
+// List APIs filter by date, not by update time, so the range starts Overlap before the checkpoint
+// to catch records changed after the previous run, ex: a payment completed after it was synced as processing.
+// Checkpoint of a kind is saved only when every page of the kind is stored, failed kinds are retried by the next run.
+// Clients which are nil are not synced.
+type Syncer struct {
+	Order          *order.Client
+	Payment        *payment.Client
+	Refund         *refund.Client
+	VirtualAccount *virtualaccount.Client
+
+	Sink            Sink
+	Checkpoint      CheckpointStore
+	Overlap         time.Duration // Default DefaultOverlap
+	InitialLookback time.Duration // Used when there is no checkpoint yet, default DefaultInitialLookback
+	PageSize        int           // Default common.DefaultPageLimit, must be between 0 and math.MaxUint16
+
+	now func() time.Time
+}
+
+// Run syncs once, see Syncer. Error of a kind does not stop other kinds, the returned error joins errors of every kind.
+// Invalid PageSize is returned before any kind is synced.
+func (s *Syncer) Run(ctx context.Context) (*Stats, error) {
+	now := time.Now()
+	if s.now != nil {
+		now = s.now()
+	}
+
+	stats := &Stats{Started: now, Kinds: map[Kind]*KindStats{}}
+
+	if s.PageSize < 0 || s.PageSize > math.MaxUint16 {
+		return stats, fmt.Errorf("syncer: PageSize %d must be between 0 and %d", s.PageSize, math.MaxUint16)
+	}
+	limit := uint16(s.PageSize)
+
+	jobs := []struct {
+		kind    Kind
+		enabled bool
+		run     syncFunc
+	}{
+		{KindOrder, s.Order != nil, s.syncOrders},
+		{KindPayment, s.Payment != nil, s.syncPayments},
+		{KindRefund, s.Refund != nil, s.syncRefunds},
+		{KindVirtualAccount, s.VirtualAccount != nil, s.syncVirtualAccounts},
+	}
+
+	errs := []error{}
+	for _, job := range jobs {
+		if !job.enabled {
+			continue
+		}
+
+		ks := &KindStats{}
+		stats.Kinds[job.kind] = ks
+
+		if ks.Err = s.syncKind(ctx, job.kind, now, limit, ks, job.run); ks.Err != nil {
+			errs = append(errs, fmt.Errorf("syncer: %s: %w", job.kind, ks.Err))
+		}
+	}
+
+	stats.Duration = time.Since(now)
+	if s.now != nil {
+		stats.Duration = s.now().Sub(now)
+	}
+
+	return stats, errors.Join(errs...)
+}
+
+// Start runs Syncer immediately and then every interval until ctx is done, onRun is called after each run when it is not nil.
+// It returns the error of ctx, or error without running when interval is not greater than 0.
+func (s *Syncer) Start(ctx context.Context, interval time.Duration, onRun func(*Stats, error)) error {
+	if interval <= 0 {
+		return fmt.Errorf("syncer: interval %s must be greater than 0", interval)
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		stats, err := s.Run(ctx)
+		if onRun != nil {
+			onRun(stats, err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncFunc upserts records of a kind within dates, fetching limit records per page.
+type syncFunc func(ctx context.Context, dates durianpay.DateRange, limit uint16, ks *KindStats) error
+
+func (s *Syncer) syncKind(ctx context.Context, kind Kind, now time.Time, limit uint16, ks *KindStats, run syncFunc) error {
+	since, err := s.Checkpoint.Load(ctx, kind)
+	if err != nil {
+		return err
+	}
+
+	if since.IsZero() {
+		lookback := s.InitialLookback
+		if lookback <= 0 {
+			lookback = DefaultInitialLookback
+		}
+		since = now.Add(-lookback)
+	} else {
+		overlap := s.Overlap
+		if overlap <= 0 {
+			overlap = DefaultOverlap
+		}
+		since = since.Add(-overlap)
+	}
+
+	ks.Range = durianpay.NewDateRange(since, now)
+	if err := run(ctx, ks.Range, limit, ks); err != nil {
+		return err
+	}
+
+	if err := s.Checkpoint.Save(ctx, kind, now); err != nil {
+		return err
+	}
+
+	ks.Checkpoint = now
+
+	return nil
+}
+
+func (s *Syncer) syncOrders(ctx context.Context, dates durianpay.DateRange, limit uint16, ks *KindStats) error {
+	return syncPages(ctx, s.Sink, dates, ks, func(ctx context.Context, dates durianpay.DateRange) *common.Iterator[order.Orders] {
+		return s.Order.IterateOrders(ctx, durianpay.OrderFetchOption{Range: dates, Limit: limit})
+	}, func(o order.Orders) Record {
+		return Record{Kind: KindOrder, ID: o.ID, Status: o.Status, UpdatedAt: o.UpdatedAt, Data: o}
+	})
+}
+
+func (s *Syncer) syncPayments(ctx context.Context, dates durianpay.DateRange, limit uint16, ks *KindStats) error {
+	return syncPages(ctx, s.Sink, dates, ks, func(ctx context.Context, dates durianpay.DateRange) *common.Iterator[payment.Payments] {
+		return s.Payment.IteratePayments(ctx, durianpay.PaymentFetchOption{Range: dates, Limit: limit})
+	}, func(p payment.Payments) Record {
+		return Record{Kind: KindPayment, ID: p.ID, Status: p.Status, UpdatedAt: p.UpdatedAt, Data: p}
+	})
+}
+
+func (s *Syncer) syncRefunds(ctx context.Context, dates durianpay.DateRange, limit uint16, ks *KindStats) error {
+	return syncPages(ctx, s.Sink, dates, ks, func(ctx context.Context, dates durianpay.DateRange) *common.Iterator[refund.Refunds] {
+		return s.Refund.IterateRefunds(ctx, durianpay.RefundFetchOption{Range: dates, Limit: limit})
+	}, func(r refund.Refunds) Record {
+		return Record{Kind: KindRefund, ID: r.ID, Status: r.Status, UpdatedAt: r.UpdatedAt, Data: r}
+	})
+}
+
+func (s *Syncer) syncVirtualAccounts(ctx context.Context, dates durianpay.DateRange, limit uint16, ks *KindStats) error {
+	return syncPages(ctx, s.Sink, dates, ks, func(ctx context.Context, dates durianpay.DateRange) *common.Iterator[virtualaccount.VirtualAccount] {
+		return s.VirtualAccount.IterateVirtualAccounts(ctx, durianpay.VirtualAccountFetchOption{Range: dates, Limit: limit})
+	}, func(va virtualaccount.VirtualAccount) Record {
+		return Record{Kind: KindVirtualAccount, ID: va.ID, Status: virtualAccountStatus(va), UpdatedAt: va.CreatedAt, Data: va}
+	})
+}
+
+// syncPages upserts every page of dates, the iterator splits dates into windows accepted by the API.
+func syncPages[T any](ctx context.Context, sink Sink, dates durianpay.DateRange, ks *KindStats, iterate func(context.Context, durianpay.DateRange) *common.Iterator[T], toRecord func(T) Record) error {
+	seen := map[string]bool{}
+
+	it := iterate(ctx, dates)
+	for it.NextPage() {
+		records := []Record{}
+		for _, item := range it.Page().Items {
+			ks.Fetched++
+
+			record := toRecord(item)
+			if seen[record.ID] {
+				ks.Duplicates++
+				continue
+			}
+			seen[record.ID] = true
+			records = append(records, record)
+		}
+
+		if len(records) == 0 {
+			continue
+		}
+
+		res, err := sink.Upsert(ctx, records)
+		if err != nil {
+			return err
+		}
+
+		ks.Inserted += res.Inserted
+		ks.Updated += res.Updated
+		ks.Unchanged += res.Unchanged
+	}
+
+	if err := it.Err(); err != nil {
+		return err.Err()
+	}
+
+	return nil
+}
+
+// virtualAccountStatus returns status of Virtual Account, which has flags instead of status.
+func virtualAccountStatus(va virtualaccount.VirtualAccount) string {
+	switch {
+	case va.IsPaid:
+		return "paid"
+	case va.IsDisabled:
+		return "disabled"
+	default:
+		return "active"
+	}
+}
@@ -0,0 +1,177 @@
+/*
+ * File Created: Monday, 19th October 2026 1:32:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package syncer
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/abmid/dpay-sdk-go/order"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+	"github.com/golang/mock/gomock"
+)
+
+// mockResponse returns DoAndReturn function which decodes body into response.
+func mockResponse(body string) func(ctx context.Context, method string, url string, param any, reqBody any, header map[string]string, response any) *durianpay.Error {
+	return func(ctx context.Context, method string, url string, param any, reqBody any, header map[string]string, response any) *durianpay.Error {
+		if err := json.Unmarshal([]byte(body), response); err != nil {
+			panic(err)
+		}
+
+		return nil
+	}
+}
+
+func TestSyncer_Run(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	checkpoint := &MemoryCheckpointStore{}
+	checkpoint.Save(context.Background(), KindPayment, now.Add(-time.Hour))
+
+	// Orders have no checkpoint, the range starts InitialLookback before now
+	orderDates := durianpay.NewDateRange(now.Add(-48*time.Hour), now)
+	// Payments start Overlap before the checkpoint
+	paymentDates := durianpay.NewDateRange(now.Add(-time.Hour-24*time.Hour), now)
+
+	gomock.InOrder(
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.OrderFetchOption{Range: orderDates, Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"orders":[{"id":"ord_1","status":"started"},{"id":"ord_2","status":"completed"}],"count":3}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.OrderFetchOption{Range: orderDates, Skip: 2, Limit: 2}, nil, nil, gomock.Any()).
+			// ord_2 is returned again because a new order shifted the page
+			DoAndReturn(mockResponse(`{"data":{"orders":[{"id":"ord_2","status":"completed"},{"id":"ord_3","status":"started"}],"count":4}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.PaymentFetchOption{Range: paymentDates, Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_1","status":"completed"}],"total":1}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+			Return(&durianpay.Error{StatusCode: 500, ErrorCode: durianpay.ErrorCodeDPAYInternalError, Message: "internal error"}),
+	)
+
+	sink := NewMemorySink()
+	sink.Upsert(context.Background(), []Record{{Kind: KindPayment, ID: "pay_1", Status: "processing", Data: payment.Payments{ID: "pay_1", Status: "processing"}}})
+
+	s := &Syncer{
+		Order:           &order.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Payment:         &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Refund:          &refund.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Sink:            sink,
+		Checkpoint:      checkpoint,
+		InitialLookback: 48 * time.Hour,
+		PageSize:        2,
+		now:             func() time.Time { return now },
+	}
+
+	stats, err := s.Run(context.Background())
+
+	if dpayErr, ok := durianpay.AsError(err); !ok || dpayErr.StatusCode != 500 {
+		t.Fatalf("Syncer.Run() error = %v, want error of refunds", err)
+	}
+
+	wantOrders := KindStats{Range: orderDates, Fetched: 4, Duplicates: 1, UpsertResult: UpsertResult{Inserted: 3}, Checkpoint: now}
+	if got := *stats.Kinds[KindOrder]; got != wantOrders {
+		t.Errorf("Syncer.Run() orders = %+v, want %+v", got, wantOrders)
+	}
+
+	wantPayments := KindStats{Range: paymentDates, Fetched: 1, UpsertResult: UpsertResult{Updated: 1}, Checkpoint: now}
+	if got := *stats.Kinds[KindPayment]; got != wantPayments {
+		t.Errorf("Syncer.Run() payments = %+v, want %+v", got, wantPayments)
+	}
+
+	if ks := stats.Kinds[KindRefund]; ks.Err == nil || !ks.Checkpoint.IsZero() {
+		t.Errorf("Syncer.Run() refunds = %+v, want error without checkpoint", ks)
+	}
+
+	if _, ok := stats.Kinds[KindVirtualAccount]; ok {
+		t.Error("Syncer.Run() synced virtual accounts without client")
+	}
+
+	if r, ok := sink.Get(KindPayment, "pay_1"); !ok || r.Status != "completed" || sink.Len(KindOrder) != 3 {
+		t.Errorf("Syncer.Run() sink payment = %+v, orders = %d", r, sink.Len(KindOrder))
+	}
+
+	for kind, want := range map[Kind]time.Time{KindOrder: now, KindPayment: now, KindRefund: {}} {
+		if got, _ := checkpoint.Load(context.Background(), kind); !got.Equal(want) {
+			t.Errorf("Syncer.Run() checkpoint of %s = %v, want %v", kind, got, want)
+		}
+	}
+}
+
+func TestSyncer_RunWindows(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	// 45 days are requested as 2 ranges accepted by the API
+	windows := durianpay.NewDateRange(now.AddDate(0, 0, -44), now).Windows()
+	if len(windows) != 2 {
+		t.Fatalf("windows = %v", windows)
+	}
+
+	gomock.InOrder(
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.RefundFetchOption{Range: windows[0], Limit: 100}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"refund":[{"id":"rfn_1","status":"done"}],"total_data":1}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.RefundFetchOption{Range: windows[1], Limit: 100}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"refund":[{"id":"rfn_2","status":"done"}],"total_data":1}}`)),
+	)
+
+	s := &Syncer{
+		Refund:          &refund.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Sink:            NewMemorySink(),
+		Checkpoint:      &MemoryCheckpointStore{},
+		InitialLookback: 44 * 24 * time.Hour,
+		PageSize:        100,
+		now:             func() time.Time { return now },
+	}
+
+	stats, err := s.Run(context.Background())
+	if err != nil || stats.Kinds[KindRefund].Inserted != 2 {
+		t.Errorf("Syncer.Run() = %+v, %v", stats.Kinds[KindRefund], err)
+	}
+}
+
+func TestSyncer_InvalidConfig(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	// No request is expected, invalid PageSize fails before any kind is synced
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	for _, pageSize := range []int{-1, math.MaxUint16 + 1} {
+		s := &Syncer{
+			Order:      &order.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+			Sink:       NewMemorySink(),
+			Checkpoint: &MemoryCheckpointStore{},
+			PageSize:   pageSize,
+		}
+
+		if _, err := s.Run(context.Background()); err == nil {
+			t.Errorf("Syncer.Run() PageSize %d error = nil, want error", pageSize)
+		}
+	}
+
+	s := &Syncer{Sink: NewMemorySink(), Checkpoint: &MemoryCheckpointStore{}}
+	if err := s.Start(context.Background(), 0, nil); err == nil {
+		t.Error("Syncer.Start() interval 0 error = nil, want error")
+	}
+}
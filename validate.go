@@ -0,0 +1,316 @@
+/*
+ * File Created: Monday, 19th October 2026 12:41:54 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	typeAmount = reflect.TypeOf(Amount{})
+	typeTime   = reflect.TypeOf(time.Time{})
+)
+
+// Validate checks payload based on `validate` tags of its fields before it is sent to DurianPay.
+// Nested structs, pointers, interfaces & slices are checked too. It returns nil if payload is valid,
+// otherwise Error with ErrorCodeSDKValidation whose Errors has the same field & message shape as the API.
+//
+// Rules are separated by comma, every rule except required is skipped for zero value:
+//
+//	required     value must not be zero, ex: empty string, zero Amount or empty slice
+//	email        string must be a valid email address
+//	amount       Amount (or decimal string) must be greater than 0
+//	numeric      string must only contain digits
+//	url          string must be an absolute http(s) URL
+//	min=N        minimum length of string & slice, or minimum value of number & Amount
+//	max=N        maximum length of string & slice, or maximum value of number & Amount
+//	len=N        exact length of string & slice
+//	oneof=A B C  value must be one of the space separated values
+//
+// Unknown rule or invalid parameter of a rule is returned as Error with ErrorCodeSDKValidation without Errors.
+//
+// Payloads with checks beyond the tags are checked by their own function instead: OrderPayload by ValidateOrder,
+// VirtualAccountPayload by ValidateVirtualAccount, VirtualAccountPatchPayload by ValidateVirtualAccountPatch
+// and PromoPayload by ValidatePromo.
+func Validate(payload any) *Error {
+	switch p := payload.(type) {
+	case PromoPayload:
+		return ValidatePromo(p)
+	case *PromoPayload:
+		if p != nil {
+			return ValidatePromo(*p)
+		}
+	case OrderPayload:
+		return ValidateOrder(p, time.Now())
+	case *OrderPayload:
+		if p != nil {
+			return ValidateOrder(*p, time.Now())
+		}
+	case VirtualAccountPayload:
+		return ValidateVirtualAccount(p)
+	case *VirtualAccountPayload:
+		if p != nil {
+			return ValidateVirtualAccount(*p)
+		}
+	case VirtualAccountPatchPayload:
+		return ValidateVirtualAccountPatch(p)
+	case *VirtualAccountPatchPayload:
+		if p != nil {
+			return ValidateVirtualAccountPatch(*p)
+		}
+	}
+
+	return validateTags(payload)
+}
+
+// validateTags checks payload based on `validate` tags only, see Validate.
+func validateTags(payload any) *Error {
+	val := &validator{}
+	val.value(reflect.ValueOf(payload), "")
+
+	if val.tagErr != nil {
+		return &Error{
+			Error:     val.tagErr.Error(),
+			ErrorCode: ErrorCodeSDKValidation,
+			Message:   val.tagErr.Error(),
+			cause:     val.tagErr,
+		}
+	}
+
+	return FromValidationErrors("invalid payload", val.errs)
+}
+
+// FromValidationErrors returns Error with ErrorCodeSDKValidation for errs found before the request is sent,
+// reason is the Error, ex: "invalid payload". It returns nil if errs is empty.
+func FromValidationErrors(reason string, errs []Errors) *Error {
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &Error{
+		Error:     reason,
+		ErrorCode: ErrorCodeSDKValidation,
+		Errors:    errs,
+		Message:   fmt.Sprintf("%d invalid field(s), first: %s", len(errs), errs[0].Message),
+	}
+}
+
+// validator collects failed rules of a payload.
+type validator struct {
+	errs   []Errors
+	tagErr error // First invalid validate tag, ex: unknown rule
+}
+
+// value walks v and appends every failed rule to errs.
+func (val *validator) value(v reflect.Value, path string) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == typeAmount || v.Type() == typeTime {
+			return
+		}
+
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			if !sf.IsExported() {
+				continue
+			}
+
+			fieldPath := path
+			if !sf.Anonymous {
+				fieldPath = joinFieldPath(path, validateFieldName(sf))
+			}
+
+			if tag := sf.Tag.Get("validate"); tag != "" && tag != "-" {
+				val.rules(v.Field(i), fieldPath, tag)
+			}
+
+			val.value(v.Field(i), fieldPath)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			val.value(v.Index(i), fmt.Sprintf("%s[%d]", path, i))
+		}
+	}
+}
+
+// rules checks every rule of tag against v.
+func (val *validator) rules(v reflect.Value, path, tag string) {
+	name := path
+	if i := strings.LastIndex(path, "."); i >= 0 {
+		name = path[i+1:]
+	}
+
+	rules := strings.Split(tag, ",")
+	if isZeroValue(v) {
+		for _, rule := range rules {
+			if rule == "required" {
+				val.errs = append(val.errs, Errors{Field: path, Message: name + " is required"})
+			}
+		}
+		return
+	}
+
+	for v.Kind() == reflect.Pointer {
+		v = v.Elem()
+	}
+
+	for _, rule := range rules {
+		rule, param, _ := strings.Cut(rule, "=")
+		message, err := checkRule(v, rule, param)
+		if err != nil {
+			if val.tagErr == nil {
+				val.tagErr = fmt.Errorf("%w of %s", err, path)
+			}
+			continue
+		}
+
+		if message != "" {
+			val.errs = append(val.errs, Errors{Field: path, Message: name + " " + message})
+		}
+	}
+}
+
+// checkRule returns message of failed rule, or empty string if v passes the rule.
+// It returns error for unknown rule or invalid parameter.
+func checkRule(v reflect.Value, rule, param string) (string, error) {
+	switch rule {
+	case "required":
+		return "", nil
+	case "email":
+		// Domain without dot (ex: user@localhost) is valid address but cannot receive email from DurianPay
+		addr, err := mail.ParseAddress(v.String())
+		if err != nil || addr.Address != v.String() || !strings.Contains(addr.Address[strings.LastIndex(addr.Address, "@"):], ".") {
+			return "must be a valid email address", nil
+		}
+	case "amount":
+		if amount, ok := amountValue(v); !ok || !amount.GreaterThan(Amount{}) {
+			return "must be a valid amount greater than 0", nil
+		}
+	case "numeric":
+		if !isDigits(v.String()) {
+			return "must only contain digits", nil
+		}
+	case "url":
+		u, err := url.ParseRequestURI(v.String())
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return "must be a valid URL", nil
+		}
+	case "min", "max", "len":
+		return checkSize(v, rule, param)
+	case "oneof":
+		s := fmt.Sprint(v.Interface())
+		for _, option := range strings.Fields(param) {
+			if s == option {
+				return "", nil
+			}
+		}
+		return "must be one of " + strings.Join(strings.Fields(param), ", "), nil
+	default:
+		return "", fmt.Errorf("durianpay: unknown validate rule %q", rule)
+	}
+
+	return "", nil
+}
+
+// checkSize checks length of string & slice, or value of number & Amount against param.
+func checkSize(v reflect.Value, rule, param string) (string, error) {
+	word := map[string]string{"min": "at least", "max": "at most", "len": "exactly"}[rule]
+
+	var got, limit int64
+	var unit string
+
+	switch v.Kind() {
+	case reflect.String:
+		got, unit = int64(utf8.RuneCountInString(v.String())), " characters"
+	case reflect.Slice, reflect.Array, reflect.Map:
+		got, unit = int64(v.Len()), " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		got = v.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		got = int64(v.Uint())
+	case reflect.Struct:
+		amount, ok := amountValue(v)
+		limitAmount, err := ParseAmount(param)
+		if !ok || err != nil {
+			return "", fmt.Errorf("durianpay: invalid validate rule %s=%s for %s", rule, param, v.Type())
+		}
+		got, limit = amount.MinorUnits(), limitAmount.MinorUnits()
+	default:
+		return "", fmt.Errorf("durianpay: invalid validate rule %s for %s", rule, v.Type())
+	}
+
+	if v.Kind() != reflect.Struct {
+		var err error
+		if limit, err = strconv.ParseInt(param, 10, 64); err != nil {
+			return "", fmt.Errorf("durianpay: invalid validate rule %s=%s", rule, param)
+		}
+	}
+
+	if (rule == "min" && got < limit) || (rule == "max" && got > limit) || (rule == "len" && got != limit) {
+		return fmt.Sprintf("must be %s %s%s", word, param, unit), nil
+	}
+
+	return "", nil
+}
+
+// amountValue returns v as Amount, v can be Amount or decimal string.
+func amountValue(v reflect.Value) (Amount, bool) {
+	switch {
+	case v.Type() == typeAmount:
+		return v.Interface().(Amount), true
+	case v.Kind() == reflect.String:
+		amount, err := ParseAmount(v.String())
+		return amount, err == nil
+	}
+
+	return Amount{}, false
+}
+
+// isZeroValue reports whether v is zero, blank string & empty slice are zero too.
+func isZeroValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.String:
+		return strings.TrimSpace(v.String()) == ""
+	case reflect.Slice, reflect.Map:
+		return v.Len() == 0
+	}
+
+	return v.IsZero()
+}
+
+// validateFieldName returns json name of field, or Go field name when it is not sent as json (ex: XIdempotencyKey).
+func validateFieldName(sf reflect.StructField) string {
+	name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		return sf.Name
+	}
+
+	return name
+}
+
+func joinFieldPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,239 @@
+/*
+ * File Created: Monday, 19th October 2026 12:41:54 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		payload    any
+		wantErrors []Errors
+	}{
+		{
+			name: "Valid disbursement",
+			payload: DisbursementPayload{
+				XIdempotencyKey: "c128fc41-46e7-42fd-93ef-cb147a8f96c8",
+				IdempotencyKey:  "1",
+				Name:            "Salary",
+				Items: []DisbursementItemPayload{
+					{
+						AccountOwnerName: "Goodman",
+						BankCode:         "bca",
+						Amount:           NewAmount(10000),
+						AccountNumber:    "222444",
+						EmailRecipient:   "goodman@domain.com",
+					},
+				},
+			},
+		},
+		{
+			name: "Invalid disbursement items",
+			payload: &DisbursementPayload{
+				XIdempotencyKey: "c128fc41-46e7-42fd-93ef-cb147a8f96c8",
+				Name:            "Salary",
+				Items: []DisbursementItemPayload{
+					{
+						AccountOwnerName: "Goodman",
+						BankCode:         "bca",
+						Amount:           NewAmount(10000),
+						AccountNumber:    "222444",
+					},
+					{
+						AccountOwnerName: " ",
+						BankCode:         "bca",
+						Amount:           NewAmount(-1),
+						AccountNumber:    "222-444",
+						EmailRecipient:   "goodman",
+					},
+				},
+			},
+			wantErrors: []Errors{
+				{Field: "IdempotencyKey", Message: "IdempotencyKey is required"},
+				{Field: "items[1].account_owner_name", Message: "account_owner_name is required"},
+				{Field: "items[1].amount", Message: "amount must be a valid amount greater than 0"},
+				{Field: "items[1].account_number", Message: "account_number must only contain digits"},
+				{Field: "items[1].email_recipient", Message: "email_recipient must be a valid email address"},
+			},
+		},
+		{
+			name: "Nested customer, enum & length",
+			payload: OrderPayload{
+				Amount:        NewAmount(10000),
+				OrderRefID:    "order_ref_001",
+				PaymentOption: "pay_later",
+				Currency:      "USD",
+				Customer: Customer{
+					Email: "jane_doe@nomail",
+				},
+				Items: []OrderItem{
+					{Name: "LED Television", Qty: 1, Price: NewAmount(10000), Logo: "tv_image.jpg"},
+				},
+			},
+			wantErrors: []Errors{
+				{Field: "payment_option", Message: "payment_option must be one of full_payment, installment"},
+				{Field: "currency", Message: "currency must be one of IDR"},
+				{Field: "customer.email", Message: "email must be a valid email address"},
+				{Field: "items[0].logo", Message: "logo must be a valid URL"},
+			},
+		},
+		{
+			name: "Payload inside interface",
+			payload: struct {
+				Type    string `json:"type"`
+				Request any    `json:"request"`
+			}{
+				Type:    "VA",
+				Request: PaymentChargeVAPayload{OrderID: "ord_1", BankCode: "BCA", Name: "Jane", Amount: MustParseAmount("0.00")},
+			},
+			wantErrors: []Errors{
+				{Field: "request.amount", Message: "amount is required"},
+			},
+		},
+		{
+			name: "Min, max & len",
+			payload: struct {
+				Name  string `json:"name" validate:"min=2,max=4"`
+				Code  string `json:"code" validate:"len=3"`
+				Qty   int    `json:"qty" validate:"min=1,max=10"`
+				Price Amount `json:"price" validate:"max=100.50"`
+				Tags  []int  `json:"tags" validate:"max=1"`
+			}{
+				Name:  "Durian",
+				Code:  "ID",
+				Qty:   11,
+				Price: MustParseAmount("100.51"),
+				Tags:  []int{1, 2},
+			},
+			wantErrors: []Errors{
+				{Field: "name", Message: "name must be at most 4 characters"},
+				{Field: "code", Message: "code must be exactly 3 characters"},
+				{Field: "qty", Message: "qty must be at most 10"},
+				{Field: "price", Message: "price must be at most 100.50"},
+				{Field: "tags", Message: "tags must be at most 1 items"},
+			},
+		},
+		{
+			name:    "Virtual account checks",
+			payload: &VirtualAccountPayload{BankCode: BankBCA, Name: "Jane Doe", IsClosed: true},
+			wantErrors: []Errors{
+				{Field: "amount", Message: "amount is required for closed virtual account"},
+			},
+		},
+		{
+			name:    "Promo checks",
+			payload: PromoPayload{Type: PromoTypeEwallet, Label: "SALE2026", DiscountType: PromoDiscountFlat, Discount: "5000", SubType: PromoSubTypeDirectDiscount},
+			wantErrors: []Errors{
+				{Field: "starts_at", Message: "starts_at is required"},
+				{Field: "ends_at", Message: "ends_at is required"},
+				{Field: "promo_details.bank_codes", Message: "bank_codes is required for ewallet_promos"},
+			},
+		},
+		{
+			name:    "Nil payload",
+			payload: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.payload)
+			if tt.wantErrors == nil {
+				if got != nil {
+					t.Errorf("Validate() = %v, want nil", got.Errors)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("Validate() = nil, want %v", tt.wantErrors)
+			}
+
+			if got.ErrorCode != ErrorCodeSDKValidation {
+				t.Errorf("Validate() ErrorCode = %v, want %v", got.ErrorCode, ErrorCodeSDKValidation)
+			}
+
+			if !reflect.DeepEqual(got.Errors, tt.wantErrors) {
+				t.Errorf("Validate() Errors = %v, want %v", got.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestValidate_FieldErrors(t *testing.T) {
+	payload := DisbursementPayload{
+		XIdempotencyKey: "1",
+		IdempotencyKey:  "1",
+		Name:            "Salary",
+		Items:           []DisbursementItemPayload{{AccountOwnerName: "Goodman", BankCode: "bca", AccountNumber: "222444"}},
+	}
+
+	want := FieldErrors{
+		{Field: "items[0].amount", Path: "Items[0].Amount", Index: 0, Message: "amount is required"},
+	}
+
+	if got := Validate(payload).FieldErrors(payload); !reflect.DeepEqual(got, want) {
+		t.Errorf("Validate().FieldErrors() = %v, want %v", got, want)
+	}
+}
+
+func TestValidate_InvalidTag(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload any
+		want    string
+	}{
+		{
+			name: "Unknown rule",
+			payload: struct {
+				Name string `json:"name" validate:"required,alpha"`
+			}{Name: "Goodman"},
+			want: `durianpay: unknown validate rule "alpha" of name`,
+		},
+		{
+			name: "Invalid param",
+			payload: struct {
+				Name string `json:"name" validate:"min=x"`
+			}{Name: "Goodman"},
+			want: "durianpay: invalid validate rule min=x of name",
+		},
+		{
+			name: "Invalid amount param",
+			payload: struct {
+				Amount Amount `json:"amount" validate:"min=abc"`
+			}{Amount: MustParseAmount("10000")},
+			want: "durianpay: invalid validate rule min=abc for durianpay.Amount of amount",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Validate(tt.payload)
+			if got == nil {
+				t.Fatalf("Validate() = nil, want %v", tt.want)
+			}
+
+			if got.ErrorCode != ErrorCodeSDKValidation || got.Error != tt.want || got.Err() == nil {
+				t.Errorf("Validate() = %+v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromValidationErrors(t *testing.T) {
+	if got := FromValidationErrors("invalid order", nil); got != nil {
+		t.Errorf("FromValidationErrors() = %v, want nil", got)
+	}
+
+	got := FromValidationErrors("invalid order", []Errors{{Field: "amount", Message: "amount is required"}, {Field: "currency", Message: "currency is required"}})
+	if got.Error != "invalid order" || got.Message != "2 invalid field(s), first: amount is required" || got.ErrorCode != ErrorCodeSDKValidation {
+		t.Errorf("FromValidationErrors() = %+v", got)
+	}
+}
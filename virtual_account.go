@@ -6,47 +6,72 @@
  */
 package durianpay
 
+import "encoding/json"
+
 /*
 Payloads
 */
 
 // VirtualAccountPayload is payload for Virtual Account Create API.
 type VirtualAccountPayload struct {
-	BankCode                string                 `json:"bank_code"`
-	Name                    string                 `json:"name"`
+	BankCode                string                 `json:"bank_code" validate:"required"`
+	Name                    string                 `json:"name" validate:"required,max=255"`
 	IsClosed                bool                   `json:"is_closed"`
-	Amount                  string                 `json:"amount"`
+	Amount                  Amount                 `json:"amount" validate:"amount"`
 	Customer                VirtualAccountCustomer `json:"customer"`
 	ExpiryMinutes           uint32                 `json:"expiry_minutes"`
-	AccountSuffix           string                 `json:"account_suffix"`
+	AccountSuffix           string                 `json:"account_suffix" validate:"numeric"`
 	IsReusable              bool                   `json:"is_reusable"`
 	VaRefID                 string                 `json:"va_ref_id"`
-	MinAmount               uint32                 `json:"min_amount"`
-	MaxAmount               uint32                 `json:"max_amount"`
+	MinAmount               Amount                 `json:"min_amount" validate:"amount"`
+	MaxAmount               Amount                 `json:"max_amount" validate:"amount"`
 	AutoDisableAfterPayment bool                   `json:"auto_disable_after_payment"`
 }
 
+// MarshalJSON implements json.Marshaler, DurianPay expects MinAmount & MaxAmount as number.
+func (p VirtualAccountPayload) MarshalJSON() ([]byte, error) {
+	type payload VirtualAccountPayload
+
+	return json.Marshal(struct {
+		payload
+		MinAmount json.Number `json:"min_amount"`
+		MaxAmount json.Number `json:"max_amount"`
+	}{payload(p), p.MinAmount.Number(), p.MaxAmount.Number()})
+}
+
 // VirtualAccountCustomer is part of VirtualAccountPayload for attribute Customer
 type VirtualAccountCustomer struct {
 	GivenName string `json:"given_name"`
-	Mobile    string `json:"mobile"`
-	Email     string `json:"email"`
+	Mobile    string `json:"mobile" phone:""`
+	Email     string `json:"email" validate:"email"`
 }
 
 // VirtualAccountPatchPayload is payload for Virtual Account Patch By ID API
 type VirtualAccountPatchPayload struct {
 	ExpiryMinutes uint32 `json:"expiry_minutes"`
-	MinAmount     uint32 `json:"min_amount"`
-	MaxAmount     uint32 `json:"max_amount"`
-	Amount        uint32 `json:"amount"`
+	MinAmount     Amount `json:"min_amount" validate:"amount"`
+	MaxAmount     Amount `json:"max_amount" validate:"amount"`
+	Amount        Amount `json:"amount" validate:"amount"`
 	IsDisabled    bool   `json:"is_disabled"`
 	VaRefID       string `json:"va_ref_id"`
 }
 
+// MarshalJSON implements json.Marshaler, DurianPay expects amounts as number.
+func (p VirtualAccountPatchPayload) MarshalJSON() ([]byte, error) {
+	type payload VirtualAccountPatchPayload
+
+	return json.Marshal(struct {
+		payload
+		MinAmount json.Number `json:"min_amount"`
+		MaxAmount json.Number `json:"max_amount"`
+		Amount    json.Number `json:"amount"`
+	}{payload(p), p.MinAmount.Number(), p.MaxAmount.Number(), p.Amount.Number()})
+}
+
 // VirtualAccountPaymentSimulatePayload is payload for Virtual Account Payment Simulate API
 type VirtualAccountPaymentSimulatePayload struct {
-	Amount        string `json:"amount"`
-	AccountNumber string `json:"account_number""`
+	Amount        Amount `json:"amount" validate:"required,amount"`
+	AccountNumber string `json:"account_number" validate:"required,numeric"`
 	ForceFail     bool   `json:"force_fail"`
 }
 
@@ -56,8 +81,7 @@ type VirtualAccountPaymentSimulatePayload struct {
 
 // VirtualAccountFetchOption is parameter for Virtual Account Fetch API
 type VirtualAccountFetchOption struct {
-	From  string `url:"from"`
-	To    string `url:"to"`
-	Skip  uint16 `url:"skip"`
-	Limit uint16 `url:"limit"`
+	Range DateRange `url:"range,omitempty"` // Sent as from & to parameters
+	Skip  uint16    `url:"skip"`
+	Limit uint16    `url:"limit"`
 }
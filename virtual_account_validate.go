@@ -0,0 +1,163 @@
+/*
+ * File Created: Monday, 19th October 2026 12:50:22 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"fmt"
+	"strings"
+)
+
+// VABankRule is Virtual Account limits of a bank. Zero limit is not checked.
+type VABankRule struct {
+	MinSuffix int    // Minimum length of AccountSuffix
+	MaxSuffix int    // Maximum length of AccountSuffix
+	MinAmount Amount // Minimum amount of a payment
+	MaxAmount Amount // Maximum amount of a payment
+}
+
+// VABankRules is Virtual Account limits by bank code, used by ValidateVirtualAccount. Banks without rule are not checked.
+//
+// DurianPay API reference does not publish amount or AccountSuffix limits per bank, so it is empty and
+// DurianPay remains the source of truth for them. Set the limits agreed in your DurianPay contract if needed.
+var VABankRules = map[string]VABankRule{}
+
+// NewClosedVirtualAccount returns payload of one-off closed VA, which is paid once with exactly amount then disabled.
+func NewClosedVirtualAccount(bankCode, name string, amount Amount, expiryMinutes uint32) VirtualAccountPayload {
+	return VirtualAccountPayload{
+		BankCode:                bankCode,
+		Name:                    name,
+		IsClosed:                true,
+		Amount:                  amount,
+		ExpiryMinutes:           expiryMinutes,
+		AutoDisableAfterPayment: true,
+	}
+}
+
+// NewOpenVirtualAccount returns payload of reusable open VA, which accepts any amount between minAmount & maxAmount.
+// Zero minAmount or maxAmount is not checked by DurianPay.
+func NewOpenVirtualAccount(bankCode, name string, minAmount, maxAmount Amount) VirtualAccountPayload {
+	return VirtualAccountPayload{
+		BankCode:   bankCode,
+		Name:       name,
+		IsReusable: true,
+		MinAmount:  minAmount,
+		MaxAmount:  maxAmount,
+	}
+}
+
+// ValidateVirtualAccount checks payload of Virtual Account Create API, in addition to `validate` tags:
+//
+//   - Closed VA has Amount and no MinAmount & MaxAmount, open VA is the opposite
+//   - MinAmount is not greater than MaxAmount, amounts are within limits of VABankRules if set
+//   - Length of AccountSuffix matches VABankRules if set
+//   - Reusable VA is not disabled after payment
+//
+// Bank code itself is left to the API, use bank.Catalog to check it before. Validate calls ValidateVirtualAccount
+// for VirtualAccountPayload. It returns nil if payload is valid, otherwise Error with ErrorCodeSDKValidation and every invalid field in Errors.
+func ValidateVirtualAccount(payload VirtualAccountPayload) *Error {
+	errs := []Errors{}
+	if err := validateTags(payload); err != nil {
+		if len(err.Errors) == 0 {
+			return err
+		}
+		errs = append(errs, err.Errors...)
+	}
+
+	bankCode := strings.ToUpper(strings.TrimSpace(payload.BankCode))
+	rule, ok := VABankRules[bankCode]
+
+	if payload.IsClosed {
+		if payload.Amount.IsZero() {
+			errs = append(errs, Errors{Field: "amount", Message: "amount is required for closed virtual account"})
+		}
+		if !payload.MinAmount.IsZero() || !payload.MaxAmount.IsZero() {
+			errs = append(errs, Errors{Field: "min_amount", Message: "min_amount & max_amount are only for open virtual account"})
+		}
+	} else {
+		if !payload.Amount.IsZero() {
+			errs = append(errs, Errors{Field: "amount", Message: "amount is only for closed virtual account, use min_amount & max_amount"})
+		}
+	}
+
+	errs = append(errs, validateVABounds(payload.MinAmount, payload.MaxAmount)...)
+
+	if ok {
+		errs = append(errs, rule.validate(bankCode, payload)...)
+	}
+
+	if payload.IsReusable && payload.AutoDisableAfterPayment {
+		errs = append(errs, Errors{Field: "auto_disable_after_payment", Message: "auto_disable_after_payment must be false for reusable virtual account"})
+	}
+
+	return vaValidationError(errs)
+}
+
+// ValidateVirtualAccountPatch checks payload of Virtual Account Patch By ID API, in addition to `validate` tags:
+// MinAmount is not greater than MaxAmount and Amount is within MinAmount & MaxAmount when they are set.
+// Validate calls ValidateVirtualAccountPatch for VirtualAccountPatchPayload.
+func ValidateVirtualAccountPatch(payload VirtualAccountPatchPayload) *Error {
+	errs := []Errors{}
+	if err := validateTags(payload); err != nil {
+		if len(err.Errors) == 0 {
+			return err
+		}
+		errs = append(errs, err.Errors...)
+	}
+
+	errs = append(errs, validateVABounds(payload.MinAmount, payload.MaxAmount)...)
+
+	if amount := payload.Amount; !amount.IsZero() {
+		if (!payload.MinAmount.IsZero() && amount.LessThan(payload.MinAmount)) || (!payload.MaxAmount.IsZero() && amount.GreaterThan(payload.MaxAmount)) {
+			errs = append(errs, Errors{Field: "amount", Message: "amount must be between min_amount and max_amount"})
+		}
+	}
+
+	return vaValidationError(errs)
+}
+
+// validate checks amounts & AccountSuffix of payload against limits of the rule which are set.
+func (rule VABankRule) validate(bankCode string, payload VirtualAccountPayload) []Errors {
+	errs := []Errors{}
+
+	for _, f := range []struct {
+		name   string
+		amount Amount
+	}{{"amount", payload.Amount}, {"min_amount", payload.MinAmount}, {"max_amount", payload.MaxAmount}} {
+		if f.amount.IsZero() {
+			continue
+		}
+
+		if (!rule.MinAmount.IsZero() && f.amount.LessThan(rule.MinAmount)) || (!rule.MaxAmount.IsZero() && f.amount.GreaterThan(rule.MaxAmount)) {
+			errs = append(errs, Errors{
+				Field:   f.name,
+				Message: fmt.Sprintf("%s must be between %s and %s for %s", f.name, rule.MinAmount.Compact(), rule.MaxAmount.Compact(), bankCode),
+			})
+		}
+	}
+
+	if n := len(payload.AccountSuffix); n > 0 && ((rule.MinSuffix > 0 && n < rule.MinSuffix) || (rule.MaxSuffix > 0 && n > rule.MaxSuffix)) {
+		errs = append(errs, Errors{
+			Field:   "account_suffix",
+			Message: fmt.Sprintf("account_suffix must be %d to %d digits for %s", rule.MinSuffix, rule.MaxSuffix, bankCode),
+		})
+	}
+
+	return errs
+}
+
+// validateVABounds checks minAmount is not greater than maxAmount when both are set.
+func validateVABounds(minAmount, maxAmount Amount) []Errors {
+	if !minAmount.IsZero() && !maxAmount.IsZero() && minAmount.GreaterThan(maxAmount) {
+		return []Errors{{Field: "min_amount", Message: "min_amount must not be greater than max_amount"}}
+	}
+
+	return nil
+}
+
+func vaValidationError(errs []Errors) *Error {
+	return FromValidationErrors("invalid virtual account", errs)
+}
@@ -0,0 +1,129 @@
+/*
+ * File Created: Monday, 19th October 2026 12:50:22 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package durianpay
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateVirtualAccount(t *testing.T) {
+	tests := []struct {
+		name       string
+		payload    VirtualAccountPayload
+		wantErrors []Errors
+	}{
+		{
+			name:    "One-off closed VA",
+			payload: NewClosedVirtualAccount(BankBCA, "Jane Doe", NewAmount(150000), 1440),
+		},
+		{
+			name:    "Reusable open VA with bounds",
+			payload: NewOpenVirtualAccount("mandiri", "Jane Doe", NewAmount(10000), NewAmount(1000000)),
+		},
+		{
+			name: "Closed VA without amount & with bounds",
+			payload: VirtualAccountPayload{
+				BankCode:  BankBNI,
+				Name:      "Jane Doe",
+				IsClosed:  true,
+				MinAmount: NewAmount(20000),
+				MaxAmount: NewAmount(10000),
+			},
+			wantErrors: []Errors{
+				{Field: "amount", Message: "amount is required for closed virtual account"},
+				{Field: "min_amount", Message: "min_amount & max_amount are only for open virtual account"},
+				{Field: "min_amount", Message: "min_amount must not be greater than max_amount"},
+			},
+		},
+		{
+			name: "Open VA with amount",
+			payload: VirtualAccountPayload{
+				BankCode: BankCIMB,
+				Name:     "Jane Doe",
+				Amount:   NewAmount(10000),
+			},
+			wantErrors: []Errors{
+				{Field: "amount", Message: "amount is only for closed virtual account, use min_amount & max_amount"},
+			},
+		},
+		{
+			name: "Reusable auto-disable",
+			payload: VirtualAccountPayload{
+				BankCode:                BankPermata,
+				Name:                    "Jane Doe",
+				IsClosed:                true,
+				Amount:                  NewAmount(5000),
+				AccountSuffix:           "123456789",
+				IsReusable:              true,
+				AutoDisableAfterPayment: true,
+			},
+			wantErrors: []Errors{
+				{Field: "auto_disable_after_payment", Message: "auto_disable_after_payment must be false for reusable virtual account"},
+			},
+		},
+		{
+			name:    "Unknown bank is left to the API",
+			payload: NewClosedVirtualAccount("NEWBANK", "Jane Doe", NewAmount(150000), 1440),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ValidateVirtualAccount(tt.payload)
+			if tt.wantErrors == nil {
+				if got != nil {
+					t.Errorf("ValidateVirtualAccount() = %v, want nil", got.Errors)
+				}
+				return
+			}
+
+			if got == nil || got.ErrorCode != ErrorCodeSDKValidation {
+				t.Fatalf("ValidateVirtualAccount() = %v, want validation error", got)
+			}
+
+			if !reflect.DeepEqual(got.Errors, tt.wantErrors) {
+				t.Errorf("ValidateVirtualAccount() Errors = %v, want %v", got.Errors, tt.wantErrors)
+			}
+		})
+	}
+}
+
+func TestVABankRule_Validate(t *testing.T) {
+	rule := VABankRule{MinSuffix: 1, MaxSuffix: 8, MinAmount: NewAmount(10000), MaxAmount: NewAmount(50000000)}
+	payload := VirtualAccountPayload{
+		BankCode:      BankPermata,
+		IsClosed:      true,
+		Amount:        NewAmount(5000),
+		AccountSuffix: "123456789",
+	}
+
+	want := []Errors{
+		{Field: "amount", Message: "amount must be between 10000 and 50000000 for PERMATA"},
+		{Field: "account_suffix", Message: "account_suffix must be 1 to 8 digits for PERMATA"},
+	}
+	if got := rule.validate(BankPermata, payload); !reflect.DeepEqual(got, want) {
+		t.Errorf("VABankRule.validate() = %v, want %v", got, want)
+	}
+
+	// Zero limits are not checked
+	if got := (VABankRule{}).validate(BankPermata, payload); len(got) != 0 {
+		t.Errorf("VABankRule{}.validate() = %v, want no errors", got)
+	}
+}
+
+func TestValidateVirtualAccountPatch(t *testing.T) {
+	if err := ValidateVirtualAccountPatch(VirtualAccountPatchPayload{MinAmount: NewAmount(11000), MaxAmount: NewAmount(13000), Amount: NewAmount(12000)}); err != nil {
+		t.Errorf("ValidateVirtualAccountPatch() = %v, want nil", err.Errors)
+	}
+
+	err := ValidateVirtualAccountPatch(VirtualAccountPatchPayload{MinAmount: NewAmount(11000), Amount: NewAmount(10000)})
+	want := []Errors{{Field: "amount", Message: "amount must be between min_amount and max_amount"}}
+	if err == nil || !reflect.DeepEqual(err.Errors, want) {
+		t.Errorf("ValidateVirtualAccountPatch() = %v, want %v", err, want)
+	}
+}
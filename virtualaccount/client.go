@@ -28,6 +28,7 @@ const (
 )
 
 // Create returns a response from Virtual Account Create API.
+// Payload is checked with durianpay.ValidateVirtualAccount by Api after phone numbers are normalized, see common.ApiImplement.Req
 //
 //	[Doc Virtual Account Create API]: https://durianpay.id/docs/api/virtual-accounts/create/
 func (c *Client) Create(ctx context.Context, payload durianpay.VirtualAccountPayload) (*Create, *durianpay.Error) {
@@ -59,6 +60,30 @@ func (c *Client) FetchVirtualAccounts(ctx context.Context, opt durianpay.Virtual
 	return &res.Data, nil
 }
 
+// IterateVirtualAccounts returns iterator over every virtual account of Virtual Accounts Fetch API, it fetches the next page when needed.
+// opt.Range longer than durianpay.MaxDateRangeDays is fetched window by window, see common.NewRangeIterator.
+// opt.Skip is the first offset and opt.Limit is number of items per page, zero uses common.DefaultPageLimit.
+func (c *Client) IterateVirtualAccounts(ctx context.Context, opt durianpay.VirtualAccountFetchOption) *common.Iterator[VirtualAccount] {
+	return common.NewRangeIterator(ctx, opt.Range, int(opt.Skip), int(opt.Limit), func(window durianpay.DateRange) common.PageFetcher[VirtualAccount] {
+		opt := opt
+		opt.Range = window
+
+		return func(ctx context.Context, skip, limit int) (common.Page[VirtualAccount], *durianpay.Error) {
+			var err *durianpay.Error
+			if opt.Skip, opt.Limit, err = common.PageUint16(skip, limit); err != nil {
+				return common.Page[VirtualAccount]{}, err
+			}
+
+			res, err := c.FetchVirtualAccounts(ctx, opt)
+			if err != nil {
+				return common.Page[VirtualAccount]{}, err
+			}
+
+			return common.Page[VirtualAccount]{Items: res.VirtualAccounts, Total: int(res.Total)}, nil
+		}
+	})
+}
+
 // FetchVirtualAccountByID returns a response from Virtual Accounts Fetch By ID API.
 //
 //	[Doc Virtual Accounts Fetch By ID API]: https://durianpay.id/docs/api/virtual-accounts/fetch-one/
@@ -77,6 +102,7 @@ func (c *Client) FetchVirtualAccountByID(ctx context.Context, ID string) (*Fetch
 }
 
 // PatchByID returns a response from Virtual Accounts Patch By ID API.
+// Payload is checked with durianpay.ValidateVirtualAccountPatch by Api, see common.ApiImplement.Req
 //
 //	[Doc Virtual Accounts Patch By ID API]: https://durianpay.id/docs/api/virtual-accounts/patch-one/
 func (c *Client) PatchByID(ctx context.Context, ID string, payload durianpay.VirtualAccountPatchPayload) (*FetchVirtualAccount, *durianpay.Error) {
@@ -12,7 +12,6 @@ import (
 	"reflect"
 	"strings"
 	"testing"
-	"time"
 
 	durianpay "github.com/abmid/dpay-sdk-go"
 	"github.com/abmid/dpay-sdk-go/internal/tests"
@@ -53,7 +52,7 @@ func TestClient_Create(t *testing.T) {
 					BankCode: "PERMATA",
 					Name:     "Abdul Hamid",
 					IsClosed: true,
-					Amount:   "12333",
+					Amount:   durianpay.NewAmount(12333),
 					Customer: durianpay.VirtualAccountCustomer{
 						GivenName: "Abdul Hamid",
 						Mobile:    "+6288888888",
@@ -61,10 +60,7 @@ func TestClient_Create(t *testing.T) {
 					},
 					ExpiryMinutes:           14400,
 					AccountSuffix:           "123456",
-					IsReusable:              true,
 					VaRefID:                 "1234",
-					MinAmount:               10000,
-					MaxAmount:               15000,
 					AutoDisableAfterPayment: true,
 				},
 			},
@@ -87,7 +83,7 @@ func TestClient_Create(t *testing.T) {
 					AccountNumber:           "190061002123456",
 					Name:                    "Abdul Hamid",
 					IsClosed:                true,
-					Amount:                  12333,
+					Amount:                  durianpay.NewAmount(12333),
 					Currency:                "IDR",
 					CustomerID:              "cus_iODsnGTdCh3706",
 					IsSandbox:               true,
@@ -104,7 +100,8 @@ func TestClient_Create(t *testing.T) {
 		{
 			name: "Internal Server Error",
 			args: args{
-				ctx: context.Background(),
+				ctx:     context.Background(),
+				payload: durianpay.NewClosedVirtualAccount("PERMATA", "Abdul Hamid", durianpay.NewAmount(12333), 14400),
 			},
 			prepare: func(m mocks, args args) {
 				m.api.EXPECT().
@@ -165,8 +162,7 @@ func TestClient_FetchVirtualAccounts(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				opt: durianpay.VirtualAccountFetchOption{
-					From:  time.Now().Format("2006-01-02"),
-					To:    time.Now().Format("2006-01-02"),
+					Range: durianpay.Today(),
 					Skip:  10,
 					Limit: 10,
 				},
@@ -190,7 +186,7 @@ func TestClient_FetchVirtualAccounts(t *testing.T) {
 						AccountNumber:           "190061002123456",
 						Name:                    "Abdul Hamid",
 						IsClosed:                true,
-						Amount:                  12333,
+						Amount:                  durianpay.NewAmount(12333),
 						Currency:                "IDR",
 						CustomerID:              "cus_iODsnGTdCh3706",
 						IsSandbox:               true,
@@ -283,7 +279,7 @@ func TestClient_FetchVirtualAccountByID(t *testing.T) {
 					AccountNumber:           "190061002123456",
 					Name:                    "Abdul Hamid",
 					IsClosed:                true,
-					Amount:                  12333,
+					Amount:                  durianpay.NewAmount(12333),
 					Currency:                "IDR",
 					CustomerID:              "cus_iODsnGTdCh3706",
 					IsSandbox:               true,
@@ -364,9 +360,9 @@ func TestClient_PatchByID(t *testing.T) {
 				ctx: context.Background(),
 				payload: durianpay.VirtualAccountPatchPayload{
 					ExpiryMinutes: 1440,
-					MinAmount:     11000,
-					MaxAmount:     13000,
-					Amount:        123456,
+					MinAmount:     durianpay.NewAmount(11000),
+					MaxAmount:     durianpay.NewAmount(13000),
+					Amount:        durianpay.NewAmount(12000),
 					IsDisabled:    true,
 					VaRefID:       "1234412",
 				},
@@ -392,7 +388,7 @@ func TestClient_PatchByID(t *testing.T) {
 					AccountNumber:           "190061002123456",
 					Name:                    "Abdul Hamid",
 					IsClosed:                true,
-					Amount:                  12333,
+					Amount:                  durianpay.NewAmount(12333),
 					Currency:                "IDR",
 					CustomerID:              "cus_iODsnGTdCh3706",
 					IsSandbox:               true,
@@ -479,7 +475,7 @@ func TestClient_PaymentSimulate(t *testing.T) {
 			args: args{
 				ctx: context.Background(),
 				payload: durianpay.VirtualAccountPaymentSimulatePayload{
-					Amount:        "12333",
+					Amount:        durianpay.NewAmount(12333),
 					AccountNumber: "88565004532522",
 					ForceFail:     false,
 				},
@@ -540,3 +536,44 @@ func TestClient_PaymentSimulate(t *testing.T) {
 		})
 	}
 }
+
+func TestClient_IterateVirtualAccounts(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	c := &Client{
+		ServerKey: featureWrap.ServerKey,
+		Api:       apiMock,
+	}
+
+	// Both pages are full, only Total stops the iterator before the third request
+	pages := map[uint16]FetchVirtualAccounts{
+		0: {VirtualAccounts: []VirtualAccount{{ID: "va_1"}, {ID: "va_2"}}, Total: 4},
+		2: {VirtualAccounts: []VirtualAccount{{ID: "va_3"}, {ID: "va_4"}}, Total: 4},
+	}
+
+	for skip, page := range pages {
+		page := page
+		opt := durianpay.VirtualAccountFetchOption{Skip: skip, Limit: 2}
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", pathVA, opt, nil, nil, gomock.Any()).
+			DoAndReturn(func(ctx context.Context, method string, url string, param any, body any, header map[string]string, response any) *durianpay.Error {
+				response.(*struct {
+					Data FetchVirtualAccounts `json:"data"`
+				}).Data = page
+				return nil
+			})
+	}
+
+	it := c.IterateVirtualAccounts(context.Background(), durianpay.VirtualAccountFetchOption{Limit: 2})
+
+	gotIDs := []string{}
+	for it.Next() {
+		gotIDs = append(gotIDs, it.Item().ID)
+	}
+
+	if it.Err() != nil || !reflect.DeepEqual(gotIDs, []string{"va_1", "va_2", "va_3", "va_4"}) || it.Page().Total != 4 {
+		t.Errorf("Client.IterateVirtualAccounts() = %v, total %v, %v", gotIDs, it.Page().Total, it.Err())
+	}
+}
@@ -8,28 +8,30 @@ package virtualaccount
 
 import (
 	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
 )
 
 // VirtualAccount is general response from Virtual Accounts API
 type VirtualAccount struct {
-	ID                      string    `json:"id"`
-	BankCode                string    `json:"bank_code"`
-	AccountNumber           string    `json:"account_number"`
-	Name                    string    `json:"name"`
-	IsClosed                bool      `json:"is_closed"`
-	Amount                  uint32    `json:"amount"`
-	Currency                string    `json:"currency"`
-	CustomerID              string    `json:"customer_id"`
-	IsSandbox               bool      `json:"is_sandbox"`
-	CreatedAt               time.Time `json:"created_at"`
-	ExpiryAt                time.Time `json:"expiry_at"`
-	IsDisabled              bool      `json:"is_disabled"`
-	IsPaid                  bool      `json:"is_paid"`
-	IsReusable              bool      `json:"is_reusable"`
-	MinAmount               *uint32   `json:"min_amount"`
-	MaxAmount               *uint32   `json:"max_amount"`
-	VaRefID                 string    `json:"va_ref_id"`
-	AutoDisableAfterPayment bool      `json:"auto_disable_after_payment"`
+	ID                      string            `json:"id"`
+	BankCode                string            `json:"bank_code"`
+	AccountNumber           string            `json:"account_number"`
+	Name                    string            `json:"name"`
+	IsClosed                bool              `json:"is_closed"`
+	Amount                  durianpay.Amount  `json:"amount"`
+	Currency                string            `json:"currency"`
+	CustomerID              string            `json:"customer_id"`
+	IsSandbox               bool              `json:"is_sandbox"`
+	CreatedAt               time.Time         `json:"created_at"`
+	ExpiryAt                time.Time         `json:"expiry_at"`
+	IsDisabled              bool              `json:"is_disabled"`
+	IsPaid                  bool              `json:"is_paid"`
+	IsReusable              bool              `json:"is_reusable"`
+	MinAmount               *durianpay.Amount `json:"min_amount"`
+	MaxAmount               *durianpay.Amount `json:"max_amount"`
+	VaRefID                 string            `json:"va_ref_id"`
+	AutoDisableAfterPayment bool              `json:"auto_disable_after_payment"`
 }
 
 // FetchVirtualAccounts is struct for response Fetch Virtual Accounts API
@@ -0,0 +1,167 @@
+/*
+ * File Created: Monday, 19th October 2026 12:27:43 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// ErrEventInFlight is returned by Deduplicator when the same event is still being processed, Handler responds 409
+// so DurianPay retries the delivery after the first one succeeds or fails.
+var ErrEventInFlight = errors.New("webhook: event is being processed")
+
+// DedupStore stores keys of processed events, see MemoryDedupStore, FileDedupStore and SQLDedupStore.
+type DedupStore interface {
+	// Seen reports whether key has been processed.
+	Seen(ctx context.Context, key string) (bool, error)
+	// MarkSeen records key as processed.
+	MarkSeen(ctx context.Context, key string) error
+}
+
+// DedupStats is statistics of Deduplicator.
+type DedupStats struct {
+	Processed  uint64 // Events passed to the next handler successfully
+	Duplicates uint64 // Duplicate deliveries acknowledged without dispatch
+	InFlight   uint64 // Deliveries rejected with ErrEventInFlight
+}
+
+// Deduplicator drops duplicate deliveries of the same event.
+// The key is recorded only after the next handler succeeds, so failed events are still retried by DurianPay.
+// A delivery of an event being processed is rejected with ErrEventInFlight, it is acknowledged only after the key is recorded.
+// In-flight deliveries are tracked per Deduplicator, processes sharing a store do not see each other's in-flight events.
+type Deduplicator struct {
+	Store   DedupStore
+	KeyFunc func(event *Event) string // Default EventKey
+
+	mu         sync.Mutex
+	inflight   map[string]struct{}
+	processed  atomic.Uint64
+	duplicates atomic.Uint64
+	rejected   atomic.Uint64
+}
+
+// NewDeduplicator returns Deduplicator backed by store.
+func NewDeduplicator(store DedupStore) *Deduplicator {
+	return &Deduplicator{
+		Store: store,
+	}
+}
+
+// Middleware returns middleware which acknowledges duplicate events without calling the next handler.
+func (d *Deduplicator) Middleware() Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, event *Event) error {
+			key := d.key(event)
+
+			if !d.acquire(key) {
+				d.rejected.Add(1)
+				return ErrEventInFlight
+			}
+			defer d.release(key)
+
+			seen, err := d.Store.Seen(ctx, key)
+			if err != nil {
+				return err
+			}
+
+			if seen {
+				d.duplicates.Add(1)
+				return nil
+			}
+
+			if err := next(ctx, event); err != nil {
+				return err
+			}
+
+			if err := d.Store.MarkSeen(ctx, key); err != nil {
+				return err
+			}
+
+			d.processed.Add(1)
+
+			return nil
+		}
+	}
+}
+
+// Stats returns number of processed, dropped duplicate and rejected in-flight events.
+func (d *Deduplicator) Stats() DedupStats {
+	return DedupStats{
+		Processed:  d.processed.Load(),
+		Duplicates: d.duplicates.Load(),
+		InFlight:   d.rejected.Load(),
+	}
+}
+
+func (d *Deduplicator) key(event *Event) string {
+	if d.KeyFunc != nil {
+		return d.KeyFunc(event)
+	}
+
+	return EventKey(event)
+}
+
+// acquire marks key as in-flight, it returns false if the same key is being processed.
+func (d *Deduplicator) acquire(key string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.inflight == nil {
+		d.inflight = map[string]struct{}{}
+	}
+
+	if _, ok := d.inflight[key]; ok {
+		return false
+	}
+
+	d.inflight[key] = struct{}{}
+
+	return true
+}
+
+func (d *Deduplicator) release(key string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.inflight, key)
+}
+
+// EventKey returns stable key of event, "<event type>:<id>".
+// The id is payment, refund, disbursement, item, VA payment or invoice transaction ID based on the event,
+// when not available the key is SHA256 of the raw body.
+func EventKey(event *Event) string {
+	var id string
+
+	switch data := event.Data.(type) {
+	case *PaymentEvent:
+		id = data.ID
+	case *OrderEvent:
+		id = data.ID
+	case *RefundEvent:
+		id = data.ID
+	case *DisbursementEvent:
+		id = data.ID
+	case *DisbursementItemEvent:
+		id = data.ID
+	case *VirtualAccountEvent:
+		id = data.PaymentID
+	case *InvoiceEvent:
+		id = data.TransactionID
+	}
+
+	if id == "" {
+		sum := sha256.Sum256(event.Raw)
+		id = hex.EncodeToString(sum[:])
+	}
+
+	return string(event.Type) + ":" + id
+}
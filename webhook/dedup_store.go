@@ -0,0 +1,367 @@
+/*
+ * File Created: Monday, 19th October 2026 12:27:43 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bufio"
+	"container/list"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryDedupStore is in-memory DedupStore with LRU eviction and TTL.
+type MemoryDedupStore struct {
+	capacity int
+	ttl      time.Duration
+	now      func() time.Time
+
+	mu    sync.Mutex
+	order *list.List
+	items map[string]*list.Element
+}
+
+type memoryDedupEntry struct {
+	key       string
+	expiresAt time.Time
+}
+
+// NewMemoryDedupStore returns MemoryDedupStore keeping at most capacity keys for ttl.
+// Capacity <= 0 means unlimited and ttl <= 0 means keys never expire.
+func NewMemoryDedupStore(capacity int, ttl time.Duration) *MemoryDedupStore {
+	return &MemoryDedupStore{
+		capacity: capacity,
+		ttl:      ttl,
+		now:      time.Now,
+		order:    list.New(),
+		items:    map[string]*list.Element{},
+	}
+}
+
+func (s *MemoryDedupStore) Seen(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.items[key]
+	if !ok {
+		return false, nil
+	}
+
+	entry := elem.Value.(*memoryDedupEntry)
+	if !entry.expiresAt.IsZero() && !s.now().Before(entry.expiresAt) {
+		s.order.Remove(elem)
+		delete(s.items, key)
+		return false, nil
+	}
+
+	s.order.MoveToFront(elem)
+
+	return true, nil
+}
+
+func (s *MemoryDedupStore) MarkSeen(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expiresAt time.Time
+	if s.ttl > 0 {
+		expiresAt = s.now().Add(s.ttl)
+	}
+
+	if elem, ok := s.items[key]; ok {
+		elem.Value.(*memoryDedupEntry).expiresAt = expiresAt
+		s.order.MoveToFront(elem)
+		return nil
+	}
+
+	s.items[key] = s.order.PushFront(&memoryDedupEntry{key: key, expiresAt: expiresAt})
+
+	if s.capacity > 0 && s.order.Len() > s.capacity {
+		oldest := s.order.Back()
+		s.order.Remove(oldest)
+		delete(s.items, oldest.Value.(*memoryDedupEntry).key)
+	}
+
+	return nil
+}
+
+// Len returns number of keys in the store, including expired keys which have not been evicted.
+func (s *MemoryDedupStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.order.Len()
+}
+
+// FileDedupStore is DedupStore persisted to an append-only file, one "<unix time>\t<key>" per line.
+// All keys are loaded into memory when the store is opened.
+type FileDedupStore struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mu   sync.Mutex
+	path string
+	file *os.File
+	seen map[string]time.Time
+}
+
+// OpenFileDedupStore opens or creates file at path, keys older than ttl are ignored (ttl <= 0 never expire).
+func OpenFileDedupStore(path string, ttl time.Duration) (*FileDedupStore, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &FileDedupStore{
+		ttl:  ttl,
+		now:  time.Now,
+		path: path,
+		file: file,
+		seen: map[string]time.Time{},
+	}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		unix, key, ok := strings.Cut(scanner.Text(), "\t")
+		if !ok {
+			continue
+		}
+
+		sec, err := strconv.ParseInt(unix, 10, 64)
+		if err != nil {
+			continue
+		}
+
+		s.seen[key] = time.Unix(sec, 0)
+	}
+
+	if err := scanner.Err(); err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *FileDedupStore) Seen(ctx context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	seenAt, ok := s.seen[key]
+	if !ok {
+		return false, nil
+	}
+
+	return !s.expired(seenAt), nil
+}
+
+func (s *FileDedupStore) MarkSeen(ctx context.Context, key string) error {
+	if strings.ContainsAny(key, "\t\n") {
+		return fmt.Errorf("webhook: invalid dedup key %q", key)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if _, err := fmt.Fprintf(s.file, "%d\t%s\n", now.Unix(), key); err != nil {
+		return err
+	}
+
+	s.seen[key] = now
+
+	return nil
+}
+
+// Compact rewrites the file without expired keys.
+// The keys are written to a temporary file which replaces the file, so a crash keeps either the old or the new file.
+func (s *FileDedupStore) Compact() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".compact-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op after rename
+
+	w := bufio.NewWriter(tmp)
+	for key, seenAt := range s.seen {
+		if !s.expired(seenAt) {
+			fmt.Fprintf(w, "%d\t%s\n", seenAt.Unix(), key)
+		}
+	}
+
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_APPEND, 0o600)
+	if err != nil {
+		return err
+	}
+
+	s.file.Close()
+	s.file = file
+
+	for key, seenAt := range s.seen {
+		if s.expired(seenAt) {
+			delete(s.seen, key)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the underlying file.
+func (s *FileDedupStore) Close() error {
+	return s.file.Close()
+}
+
+func (s *FileDedupStore) expired(seenAt time.Time) bool {
+	return s.ttl > 0 && !s.now().Before(seenAt.Add(s.ttl))
+}
+
+// SQLDedupStore is DedupStore backed by database/sql.
+// The table must have columns event_key (primary key) and seen_at, see SQLDedupStore.CreateTable.
+//
+// Seen followed by MarkSeen is not atomic, Deduplicator rejects a delivery in flight only within its process.
+// Processes sharing the table may both process the same event when its deliveries arrive at the same time.
+type SQLDedupStore struct {
+	DB          *sql.DB
+	Table       string             // Default "durianpay_webhook_dedup"
+	TTL         time.Duration      // Keys older than TTL are considered not seen, <= 0 never expire
+	Placeholder func(n int) string // Default "?", use func(n int) string { return "$" + strconv.Itoa(n) } for PostgreSQL
+}
+
+// NewSQLDedupStore returns SQLDedupStore with default table and placeholder.
+func NewSQLDedupStore(db *sql.DB, ttl time.Duration) *SQLDedupStore {
+	return &SQLDedupStore{
+		DB:  db,
+		TTL: ttl,
+	}
+}
+
+// CreateTable creates the dedup table if not exists.
+func (s *SQLDedupStore) CreateTable(ctx context.Context) error {
+	query := fmt.Sprintf("CREATE TABLE IF NOT EXISTS %s (event_key VARCHAR(255) PRIMARY KEY, seen_at TIMESTAMP NOT NULL)", s.table())
+	_, err := s.DB.ExecContext(ctx, query)
+
+	return err
+}
+
+func (s *SQLDedupStore) Seen(ctx context.Context, key string) (bool, error) {
+	var seenAt time.Time
+
+	query := fmt.Sprintf("SELECT seen_at FROM %s WHERE event_key = %s", s.table(), s.placeholder(1))
+	err := s.DB.QueryRowContext(ctx, query, key).Scan(&seenAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return s.TTL <= 0 || time.Since(seenAt) < s.TTL, nil
+}
+
+func (s *SQLDedupStore) MarkSeen(ctx context.Context, key string) error {
+	now := time.Now().UTC()
+
+	exists, err := s.exists(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return s.update(ctx, key, now)
+	}
+
+	query := fmt.Sprintf("INSERT INTO %s (event_key, seen_at) VALUES (%s, %s)", s.table(), s.placeholder(1), s.placeholder(2))
+	_, err = s.DB.ExecContext(ctx, query, key, now)
+	if err == nil {
+		return nil
+	}
+
+	// Another delivery inserted the key after the SELECT, the primary key rejects the INSERT
+	if exists, existsErr := s.exists(ctx, key); existsErr == nil && exists {
+		return s.update(ctx, key, now)
+	}
+
+	return err
+}
+
+// exists reports whether key has a row, expired or not.
+// Row count is used instead of RowsAffected of UPDATE, MySQL reports 0 affected rows when seen_at does not change.
+func (s *SQLDedupStore) exists(ctx context.Context, key string) (bool, error) {
+	var count int
+
+	query := fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE event_key = %s", s.table(), s.placeholder(1))
+	if err := s.DB.QueryRowContext(ctx, query, key).Scan(&count); err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// update sets seen_at of key.
+func (s *SQLDedupStore) update(ctx context.Context, key string, now time.Time) error {
+	query := fmt.Sprintf("UPDATE %s SET seen_at = %s WHERE event_key = %s", s.table(), s.placeholder(1), s.placeholder(2))
+	_, err := s.DB.ExecContext(ctx, query, now, key)
+
+	return err
+}
+
+// DeleteExpired deletes keys older than TTL.
+func (s *SQLDedupStore) DeleteExpired(ctx context.Context) error {
+	if s.TTL <= 0 {
+		return nil
+	}
+
+	query := fmt.Sprintf("DELETE FROM %s WHERE seen_at < %s", s.table(), s.placeholder(1))
+	_, err := s.DB.ExecContext(ctx, query, time.Now().UTC().Add(-s.TTL))
+
+	return err
+}
+
+func (s *SQLDedupStore) table() string {
+	if s.Table == "" {
+		return "durianpay_webhook_dedup"
+	}
+
+	return s.Table
+}
+
+func (s *SQLDedupStore) placeholder(n int) string {
+	if s.Placeholder == nil {
+		return "?"
+	}
+
+	return s.Placeholder(n)
+}
@@ -0,0 +1,245 @@
+/*
+ * File Created: Monday, 19th October 2026 12:27:43 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests/sqlfake"
+)
+
+func TestMemoryDedupStore(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+
+	s := NewMemoryDedupStore(2, time.Minute)
+	s.now = func() time.Time { return now }
+
+	s.MarkSeen(ctx, "a")
+	s.MarkSeen(ctx, "b")
+
+	// Access "a" so "b" becomes the least recently used
+	if seen, _ := s.Seen(ctx, "a"); !seen {
+		t.Errorf("Seen(a) = false, want true")
+	}
+
+	s.MarkSeen(ctx, "c")
+	if seen, _ := s.Seen(ctx, "b"); seen {
+		t.Errorf("Seen(b) = true, want false after eviction")
+	}
+
+	now = now.Add(time.Minute)
+	if seen, _ := s.Seen(ctx, "a"); seen {
+		t.Errorf("Seen(a) = true, want false after ttl")
+	}
+
+	if s.Len() != 1 {
+		t.Errorf("Len() = %v, want %v", s.Len(), 1)
+	}
+}
+
+func TestFileDedupStore(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "dedup.log")
+
+	s, err := OpenFileDedupStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.MarkSeen(ctx, "payment.completed:pay_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.MarkSeen(ctx, "bad\tkey"); err == nil {
+		t.Errorf("MarkSeen() with tab err = nil, want error")
+	}
+	s.Close()
+
+	reopened, err := OpenFileDedupStore(path, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer reopened.Close()
+
+	if seen, _ := reopened.Seen(ctx, "payment.completed:pay_1"); !seen {
+		t.Errorf("Seen() after reopen = false, want true")
+	}
+
+	reopened.now = func() time.Time { return time.Now().Add(2 * time.Hour) }
+	if seen, _ := reopened.Seen(ctx, "payment.completed:pay_1"); seen {
+		t.Errorf("Seen() after ttl = true, want false")
+	}
+
+	if err := reopened.MarkSeen(ctx, "payment.completed:pay_2"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := reopened.Compact(); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(reopened.seen) != 1 {
+		t.Errorf("Compact() keys = %v, want 1", len(reopened.seen))
+	}
+
+	// Keys marked after Compact are appended to the new file
+	if err := reopened.MarkSeen(ctx, "payment.completed:pay_3"); err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if lines := strings.Split(strings.TrimSpace(string(b)), "\n"); len(lines) != 2 ||
+		!strings.HasSuffix(lines[0], "\tpayment.completed:pay_2") || !strings.HasSuffix(lines[1], "\tpayment.completed:pay_3") {
+		t.Errorf("file after Compact() = %q", b)
+	}
+
+	if entries, _ := os.ReadDir(filepath.Dir(path)); len(entries) != 1 {
+		t.Errorf("Compact() left %v files, want only the store file", len(entries))
+	}
+}
+
+// fakeDedupStore is database which understands only the statements of SQLDedupStore.
+type fakeDedupStore struct {
+	keys map[string]time.Time
+	// beforeInsert is called before INSERT is executed, ex: to insert the key from another delivery
+	beforeInsert func(keys map[string]time.Time) error
+}
+
+func openFakeDedupStore(t *testing.T) (*sql.DB, *sqlfake.DB, *fakeDedupStore) {
+	fake := &fakeDedupStore{keys: map[string]time.Time{}}
+	d := &sqlfake.DB{Exec: fake.exec, Query: fake.query}
+
+	return sqlfake.Open(t, d), d, fake
+}
+
+func (f *fakeDedupStore) exec(query string, args []driver.Value) (driver.Result, error) {
+	switch {
+	case strings.HasPrefix(query, "UPDATE"):
+		key := args[1].(string)
+		if _, ok := f.keys[key]; !ok {
+			return driver.RowsAffected(0), nil
+		}
+		f.keys[key] = args[0].(time.Time)
+	case strings.HasPrefix(query, "INSERT"):
+		if f.beforeInsert != nil {
+			if err := f.beforeInsert(f.keys); err != nil {
+				return nil, err
+			}
+		}
+
+		key := args[0].(string)
+		if _, ok := f.keys[key]; ok {
+			return nil, errors.New("UNIQUE constraint failed: event_key")
+		}
+		f.keys[key] = args[1].(time.Time)
+	case strings.HasPrefix(query, "DELETE"):
+		for key, seenAt := range f.keys {
+			if seenAt.Before(args[0].(time.Time)) {
+				delete(f.keys, key)
+			}
+		}
+	}
+
+	return driver.RowsAffected(1), nil
+}
+
+func (f *fakeDedupStore) query(query string, args []driver.Value) ([]driver.Value, error) {
+	seenAt, ok := f.keys[args[0].(string)]
+	if strings.HasPrefix(query, "SELECT COUNT") {
+		count := int64(0)
+		if ok {
+			count = 1
+		}
+		return []driver.Value{count}, nil
+	}
+
+	if !ok {
+		return nil, nil
+	}
+
+	return []driver.Value{seenAt}, nil
+}
+
+func TestSQLDedupStore(t *testing.T) {
+	ctx := context.Background()
+	db, d, fake := openFakeDedupStore(t)
+	s := NewSQLDedupStore(db, time.Hour)
+
+	if seen, err := s.Seen(ctx, "payment.completed:pay_1"); err != nil || seen {
+		t.Fatalf("Seen() = %v, %v, want false", seen, err)
+	}
+
+	d.Reset()
+	if err := s.MarkSeen(ctx, "payment.completed:pay_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	// UPDATE of the same key does not depend on affected rows, ex: MySQL reports 0 when seen_at is unchanged
+	if err := s.MarkSeen(ctx, "payment.completed:pay_1"); err != nil {
+		t.Fatal(err)
+	}
+
+	if want := []string{"SELECT", "INSERT", "SELECT", "UPDATE"}; !reflect.DeepEqual(d.Verbs(), want) {
+		t.Errorf("MarkSeen() statements = %v, want %v", d.Verbs(), want)
+	}
+
+	if seen, err := s.Seen(ctx, "payment.completed:pay_1"); err != nil || !seen {
+		t.Errorf("Seen() = %v, %v, want true", seen, err)
+	}
+
+	fake.keys["payment.completed:pay_old"] = time.Now().Add(-2 * time.Hour)
+	if seen, _ := s.Seen(ctx, "payment.completed:pay_old"); seen {
+		t.Errorf("Seen() after ttl = true, want false")
+	}
+
+	if err := s.DeleteExpired(ctx); err != nil || len(fake.keys) != 1 {
+		t.Errorf("DeleteExpired() = %v, keys = %v", err, fake.keys)
+	}
+}
+
+func TestSQLDedupStore_MarkSeenRace(t *testing.T) {
+	ctx := context.Background()
+	db, d, fake := openFakeDedupStore(t)
+	s := &SQLDedupStore{DB: db, Placeholder: func(n int) string { return "$" + strconv.Itoa(n) }}
+
+	// Another delivery inserts the key between SELECT & INSERT
+	fake.beforeInsert = func(keys map[string]time.Time) error {
+		keys["payment.completed:pay_1"] = time.Now()
+		return nil
+	}
+
+	if err := s.MarkSeen(ctx, "payment.completed:pay_1"); err != nil {
+		t.Errorf("MarkSeen() error = %v, want nil after the key is inserted by another delivery", err)
+	}
+
+	if want := []string{"SELECT", "INSERT", "SELECT", "UPDATE"}; !reflect.DeepEqual(d.Verbs(), want) {
+		t.Errorf("MarkSeen() statements = %v, want %v", d.Verbs(), want)
+	}
+
+	// INSERT error is returned when the key still does not exist
+	fake.beforeInsert = func(keys map[string]time.Time) error {
+		return errors.New("disk full")
+	}
+	if err := s.MarkSeen(ctx, "payment.completed:pay_2"); err == nil || err.Error() != "disk full" {
+		t.Errorf("MarkSeen() error = %v, want disk full", err)
+	}
+}
@@ -0,0 +1,157 @@
+/*
+ * File Created: Monday, 19th October 2026 12:27:43 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestDeduplicator_Middleware(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dedup := NewDeduplicator(NewMemoryDedupStore(100, time.Hour))
+
+	calls := 0
+	fail := true
+	d := NewDispatcher()
+	d.Use(dedup.Middleware())
+	d.OnPaymentCompleted(func(ctx context.Context, event PaymentEvent) error {
+		calls++
+		if fail {
+			return errors.New("temporary failure")
+		}
+		return nil
+	})
+
+	// Failed delivery must not be recorded, so the retry is dispatched again
+	if err := d.Dispatch(context.Background(), event); err == nil {
+		t.Fatal("Dispatch() err = nil, want error")
+	}
+
+	fail = false
+	for i := 0; i < 3; i++ {
+		if err := d.Dispatch(context.Background(), event); err != nil {
+			t.Fatalf("Dispatch() err = %v", err)
+		}
+	}
+
+	if calls != 2 {
+		t.Errorf("handler calls = %v, want %v", calls, 2)
+	}
+
+	if got, want := dedup.Stats(), (DedupStats{Processed: 1, Duplicates: 2}); got != want {
+		t.Errorf("Deduplicator.Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDeduplicator_MiddlewareInFlight(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dedup := NewDeduplicator(NewMemoryDedupStore(100, time.Hour))
+
+	started, finish := make(chan struct{}), make(chan error)
+	handler := dedup.Middleware()(func(ctx context.Context, event *Event) error {
+		started <- struct{}{}
+		return <-finish
+	})
+
+	first := make(chan error)
+	go func() { first <- handler(context.Background(), event) }()
+	<-started
+
+	// The first delivery may still fail, the retry must not be acknowledged
+	if err := handler(context.Background(), event); !errors.Is(err, ErrEventInFlight) {
+		t.Errorf("Middleware() in-flight err = %v, want ErrEventInFlight", err)
+	}
+
+	finish <- errors.New("temporary failure")
+	if err := <-first; err == nil {
+		t.Fatal("Middleware() first err = nil, want error")
+	}
+
+	// The event is not marked seen, the next delivery is processed
+	go func() { first <- handler(context.Background(), event) }()
+	<-started
+	finish <- nil
+	if err := <-first; err != nil {
+		t.Errorf("Middleware() retry err = %v", err)
+	}
+
+	if got, want := dedup.Stats(), (DedupStats{Processed: 1, InFlight: 1}); got != want {
+		t.Errorf("Deduplicator.Stats() = %+v, want %+v", got, want)
+	}
+
+	if code := statusCode(fmt.Errorf("dispatch: %w", ErrEventInFlight)); code != http.StatusConflict {
+		t.Errorf("statusCode(ErrEventInFlight) = %v, want %v", code, http.StatusConflict)
+	}
+}
+
+func TestEventKey(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	tests := []struct {
+		name       string
+		file       string
+		want       string
+		wantPrefix string
+	}{
+		{
+			name: "Payment",
+			file: "payment_completed.json",
+			want: "payment.completed:pay_pYQ319c4qo5956",
+		},
+		{
+			name: "Virtual account uses payment ID",
+			file: "virtual_account_paid.json",
+			want: "virtual_account.paid:pay_aB12Cd34Ef5678",
+		},
+		{
+			name:       "Unknown uses body hash",
+			file:       "unknown.json",
+			wantPrefix: "subscription.renewed:",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + tt.file))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := EventKey(event)
+			if tt.want != "" && got != tt.want {
+				t.Errorf("EventKey() = %v, want %v", got, tt.want)
+			}
+
+			if tt.wantPrefix != "" && (!strings.HasPrefix(got, tt.wantPrefix) || len(got) != len(tt.wantPrefix)+64) {
+				t.Errorf("EventKey() = %v, want prefix %v with sha256", got, tt.wantPrefix)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+ * File Created: Monday, 19th October 2026 12:25:32 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Dispatcher routes webhook events to handlers registered per event type.
+//
+// Use Dispatcher.Handler to receive webhooks over HTTP, or Dispatcher.Dispatch as HandlerFunc.
+type Dispatcher struct {
+	mu          sync.RWMutex
+	handlers    map[EventType]HandlerFunc
+	middlewares []Middleware
+	unknown     HandlerFunc
+}
+
+// NewDispatcher returns Dispatcher without any handler.
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: map[EventType]HandlerFunc{},
+	}
+}
+
+// Use appends middlewares, the first middleware is the outermost.
+func (d *Dispatcher) Use(middlewares ...Middleware) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.middlewares = append(d.middlewares, middlewares...)
+}
+
+// On registers fn for eventType, it replaces previous handler of the same event type.
+func (d *Dispatcher) On(eventType EventType, fn HandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[eventType] = fn
+}
+
+// OnUnknown registers fn for events without handler.
+// Without this fallback those events are acknowledged and ignored, so DurianPay will not retry them.
+func (d *Dispatcher) OnUnknown(fn HandlerFunc) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.unknown = fn
+}
+
+// Dispatch calls the handler registered for event type wrapped by middlewares.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) error {
+	d.mu.RLock()
+	fn, ok := d.handlers[event.Type]
+	if !ok {
+		fn = d.unknown
+	}
+	middlewares := d.middlewares
+	d.mu.RUnlock()
+
+	if fn == nil {
+		fn = func(ctx context.Context, event *Event) error { return nil }
+	}
+
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		fn = middlewares[i](fn)
+	}
+
+	return fn(ctx, event)
+}
+
+// Handler returns http.Handler which dispatches received webhooks.
+func (d *Dispatcher) Handler() *Handler {
+	return NewHandler(d.Dispatch)
+}
+
+// OnPaymentCompleted registers handler for payment.completed event.
+func (d *Dispatcher) OnPaymentCompleted(fn func(ctx context.Context, event PaymentEvent) error) {
+	d.On(EventPaymentCompleted, typed(fn))
+}
+
+// OnPaymentFailed registers handler for payment.failed event.
+func (d *Dispatcher) OnPaymentFailed(fn func(ctx context.Context, event PaymentEvent) error) {
+	d.On(EventPaymentFailed, typed(fn))
+}
+
+// OnPaymentExpired registers handler for payment.expired event.
+func (d *Dispatcher) OnPaymentExpired(fn func(ctx context.Context, event PaymentEvent) error) {
+	d.On(EventPaymentExpired, typed(fn))
+}
+
+// OnOrderCompleted registers handler for order.completed event.
+func (d *Dispatcher) OnOrderCompleted(fn func(ctx context.Context, event OrderEvent) error) {
+	d.On(EventOrderCompleted, typed(fn))
+}
+
+// OnOrderExpired registers handler for order.expired event.
+func (d *Dispatcher) OnOrderExpired(fn func(ctx context.Context, event OrderEvent) error) {
+	d.On(EventOrderExpired, typed(fn))
+}
+
+// OnRefundCompleted registers handler for refund.completed event.
+func (d *Dispatcher) OnRefundCompleted(fn func(ctx context.Context, event RefundEvent) error) {
+	d.On(EventRefundCompleted, typed(fn))
+}
+
+// OnRefundFailed registers handler for refund.failed event.
+func (d *Dispatcher) OnRefundFailed(fn func(ctx context.Context, event RefundEvent) error) {
+	d.On(EventRefundFailed, typed(fn))
+}
+
+// OnDisbursementCompleted registers handler for disbursement.completed event.
+func (d *Dispatcher) OnDisbursementCompleted(fn func(ctx context.Context, event DisbursementEvent) error) {
+	d.On(EventDisbursementCompleted, typed(fn))
+}
+
+// OnDisbursementFailed registers handler for disbursement.failed event.
+func (d *Dispatcher) OnDisbursementFailed(fn func(ctx context.Context, event DisbursementEvent) error) {
+	d.On(EventDisbursementFailed, typed(fn))
+}
+
+// OnDisbursementItemCompleted registers handler for disbursement_item.completed event.
+func (d *Dispatcher) OnDisbursementItemCompleted(fn func(ctx context.Context, event DisbursementItemEvent) error) {
+	d.On(EventDisbursementItemCompleted, typed(fn))
+}
+
+// OnDisbursementItemFailed registers handler for disbursement_item.failed event.
+func (d *Dispatcher) OnDisbursementItemFailed(fn func(ctx context.Context, event DisbursementItemEvent) error) {
+	d.On(EventDisbursementItemFailed, typed(fn))
+}
+
+// OnVirtualAccountPaid registers handler for virtual_account.paid event.
+func (d *Dispatcher) OnVirtualAccountPaid(fn func(ctx context.Context, event VirtualAccountEvent) error) {
+	d.On(EventVirtualAccountPaid, typed(fn))
+}
+
+// OnInvoicePaid registers handler for invoice.paid event.
+func (d *Dispatcher) OnInvoicePaid(fn func(ctx context.Context, event InvoiceEvent) error) {
+	d.On(EventInvoicePaid, typed(fn))
+}
+
+// typed converts handler of typed event data into HandlerFunc.
+func typed[T any](fn func(ctx context.Context, event T) error) HandlerFunc {
+	return func(ctx context.Context, event *Event) error {
+		data, ok := event.Data.(*T)
+		if !ok {
+			return fmt.Errorf("%w: unexpected data %T for event %s", ErrInvalidPayload, event.Data, event.Type)
+		}
+
+		return fn(ctx, *data)
+	}
+}
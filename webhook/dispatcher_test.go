@@ -0,0 +1,132 @@
+/*
+ * File Created: Monday, 19th October 2026 12:25:32 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		file           string
+		prepare        func(d *Dispatcher, called *[]string)
+		wantStatusCode int
+		wantCalled     []string
+	}{
+		{
+			name: "Typed payment handler",
+			file: "payment_completed.json",
+			prepare: func(d *Dispatcher, called *[]string) {
+				d.OnPaymentCompleted(func(ctx context.Context, event PaymentEvent) error {
+					*called = append(*called, "payment.completed:"+event.ID)
+					return nil
+				})
+				d.OnPaymentFailed(func(ctx context.Context, event PaymentEvent) error {
+					*called = append(*called, "payment.failed")
+					return nil
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantCalled:     []string{"payment.completed:pay_pYQ319c4qo5956"},
+		},
+		{
+			name: "Typed disbursement item handler returns error",
+			file: "disbursement_item_failed.json",
+			prepare: func(d *Dispatcher, called *[]string) {
+				d.OnDisbursementItemFailed(func(ctx context.Context, event DisbursementItemEvent) error {
+					*called = append(*called, "disbursement_item.failed:"+event.FailureReson)
+					return errors.New("unable to update ledger")
+				})
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantCalled:     []string{"disbursement_item.failed:invalid account number"},
+		},
+		{
+			name: "Unknown event with fallback",
+			file: "unknown.json",
+			prepare: func(d *Dispatcher, called *[]string) {
+				d.OnUnknown(func(ctx context.Context, event *Event) error {
+					*called = append(*called, "unknown:"+string(event.Type))
+					return nil
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantCalled:     []string{"unknown:subscription.renewed"},
+		},
+		{
+			name:           "Unknown event without fallback is acknowledged",
+			file:           "virtual_account_paid.json",
+			prepare:        func(d *Dispatcher, called *[]string) {},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Middleware order",
+			file: "virtual_account_paid.json",
+			prepare: func(d *Dispatcher, called *[]string) {
+				for _, name := range []string{"first", "second"} {
+					name := name
+					d.Use(func(next HandlerFunc) HandlerFunc {
+						return func(ctx context.Context, event *Event) error {
+							*called = append(*called, name)
+							return next(ctx, event)
+						}
+					})
+				}
+				d.OnVirtualAccountPaid(func(ctx context.Context, event VirtualAccountEvent) error {
+					*called = append(*called, "virtual_account.paid:"+event.PaymentID)
+					return nil
+				})
+			},
+			wantStatusCode: http.StatusOK,
+			wantCalled:     []string{"first", "second", "virtual_account.paid:pay_aB12Cd34Ef5678"},
+		},
+		{
+			name: "Wrong data type for registered event",
+			file: "unknown.json",
+			prepare: func(d *Dispatcher, called *[]string) {
+				d.On("subscription.renewed", typed(func(ctx context.Context, event PaymentEvent) error {
+					*called = append(*called, "should not be called")
+					return nil
+				}))
+			},
+			wantStatusCode: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called []string
+
+			d := NewDispatcher()
+			tt.prepare(d, &called)
+
+			rec := httptest.NewRecorder()
+			body := featureWrap.ResJSONByte(pathPayloadWebhook + tt.file)
+			d.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Dispatcher status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			if !reflect.DeepEqual(called, tt.wantCalled) {
+				t.Errorf("Dispatcher called = %v, want %v", called, tt.wantCalled)
+			}
+		})
+	}
+}
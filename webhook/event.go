@@ -0,0 +1,163 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/invoice"
+	"github.com/abmid/dpay-sdk-go/order"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+	"github.com/abmid/dpay-sdk-go/virtualaccount"
+)
+
+// EventType is value of attribute event in DurianPay webhook payload.
+type EventType string
+
+const (
+	EventPaymentCompleted          EventType = "payment.completed"
+	EventPaymentFailed             EventType = "payment.failed"
+	EventPaymentExpired            EventType = "payment.expired"
+	EventOrderCompleted            EventType = "order.completed"
+	EventOrderExpired              EventType = "order.expired"
+	EventRefundCompleted           EventType = "refund.completed"
+	EventRefundFailed              EventType = "refund.failed"
+	EventDisbursementCompleted     EventType = "disbursement.completed"
+	EventDisbursementFailed        EventType = "disbursement.failed"
+	EventDisbursementItemCompleted EventType = "disbursement_item.completed"
+	EventDisbursementItemFailed    EventType = "disbursement_item.failed"
+	EventVirtualAccountPaid        EventType = "virtual_account.paid"
+	EventInvoicePaid               EventType = "invoice.paid"
+)
+
+// ErrInvalidPayload is returned when the webhook body is not a valid DurianPay event.
+var ErrInvalidPayload = errors.New("webhook: invalid payload")
+
+// Event is a parsed DurianPay webhook.
+// Data holds one of *PaymentEvent, *OrderEvent, *RefundEvent, *DisbursementEvent, *DisbursementItemEvent,
+// *VirtualAccountEvent or *InvoiceEvent based on Type, and json.RawMessage for unknown event type.
+type Event struct {
+	Type      EventType `json:"event"`
+	Data      any       `json:"data"`
+	Raw       []byte    `json:"-"` // Raw is original webhook body
+	Synthetic bool      `json:"-"` // Synthetic is true for event reconstructed by Reconciler
+}
+
+// PaymentEvent is data for payment.* events
+type PaymentEvent struct {
+	payment.Payments
+	Signature string `json:"signature"`
+}
+
+// OrderEvent is data for order.* events
+type OrderEvent struct {
+	order.Orders
+}
+
+// RefundEvent is data for refund.* events
+type RefundEvent struct {
+	refund.Refunds
+}
+
+// DisbursementEvent is data for disbursement.* events
+type DisbursementEvent struct {
+	disbursement.Disbursement
+}
+
+// DisbursementItemEvent is data for disbursement_item.* events
+type DisbursementItemEvent struct {
+	disbursement.DisbursementBatchItem
+}
+
+// VirtualAccountEvent is data for virtual_account.* events
+type VirtualAccountEvent struct {
+	virtualaccount.VirtualAccount
+	PaymentID  string           `json:"payment_id"`
+	PaidAmount durianpay.Amount `json:"paid_amount"`
+	PaidAt     time.Time        `json:"paid_at"`
+}
+
+// InvoiceEvent is data for invoice.* events
+type InvoiceEvent struct {
+	invoice.Invoices
+	TransactionID string           `json:"transaction_id"`
+	PaidAmount    durianpay.Amount `json:"paid_amount"`
+	PaidAt        time.Time        `json:"paid_at"`
+}
+
+// VerifySignature reports whether Signature is valid for the payment, see payment.VerifySignature.
+func (e *PaymentEvent) VerifySignature(serverKey string) bool {
+	return payment.VerifySignature(serverKey, e.OrderID, e.ID, e.Signature)
+}
+
+// ParseEvent parses webhook body into Event with typed Data.
+func ParseEvent(body []byte) (*Event, error) {
+	envelope := struct {
+		Event EventType       `json:"event"`
+		Data  json.RawMessage `json:"data"`
+	}{}
+
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+	}
+
+	if envelope.Event == "" {
+		return nil, fmt.Errorf("%w: attribute event is empty", ErrInvalidPayload)
+	}
+
+	event := &Event{
+		Type: envelope.Event,
+		Raw:  body,
+	}
+
+	data := newEventData(envelope.Event)
+	if data == nil {
+		event.Data = envelope.Data
+		return event, nil
+	}
+
+	if len(envelope.Data) > 0 {
+		if err := json.Unmarshal(envelope.Data, data); err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidPayload, err)
+		}
+	}
+
+	event.Data = data
+
+	return event, nil
+}
+
+// newEventData returns pointer of typed data for event type, or nil for unknown event type.
+func newEventData(eventType EventType) any {
+	resource, _, _ := strings.Cut(string(eventType), ".")
+
+	switch resource {
+	case "payment":
+		return &PaymentEvent{}
+	case "order":
+		return &OrderEvent{}
+	case "refund":
+		return &RefundEvent{}
+	case "disbursement":
+		return &DisbursementEvent{}
+	case "disbursement_item":
+		return &DisbursementItemEvent{}
+	case "virtual_account":
+		return &VirtualAccountEvent{}
+	case "invoice":
+		return &InvoiceEvent{}
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/virtualaccount"
+)
+
+const (
+	pathPayloadWebhook = "../internal/tests/payload/webhook/"
+)
+
+func TestParseEvent(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	tests := []struct {
+		name     string
+		body     []byte
+		wantType EventType
+		wantData any
+		wantErr  error
+	}{
+		{
+			name:     "Payment completed",
+			body:     featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"),
+			wantType: EventPaymentCompleted,
+			wantData: &PaymentEvent{
+				Payments: payment.Payments{
+					ID:                 "pay_pYQ319c4qo5956",
+					OrderID:            "ord_VN5nVJpSW27112",
+					PaymentRefID:       "pay_ref_123",
+					Amount:             durianpay.MustParseAmount("20000.00"),
+					Status:             "completed",
+					PaymentDetailsType: "va_details",
+					MethodID:           "MANDIRI",
+					CreatedAt:          tests.StringToTime("2023-09-05T09:00:00Z"),
+					UpdatedAt:          tests.StringToTime("2023-09-05T09:10:00Z"),
+					PaidAmount:         durianpay.MustParseAmount("20000.00"),
+					Currency:           "IDR",
+				},
+				Signature: "8450ccee779745741fa1c50ea5a438dd8564594bc5ece6ba20e91473e2db0e30",
+			},
+		},
+		{
+			name:     "Disbursement item failed",
+			body:     featureWrap.ResJSONByte(pathPayloadWebhook + "disbursement_item_failed.json"),
+			wantType: EventDisbursementItemFailed,
+			wantData: &DisbursementItemEvent{
+				DisbursementBatchItem: disbursement.DisbursementBatchItem{
+					ID:                  "dis_item_XrNh3s7Cc4321",
+					DisbursementBatchID: "dis_LjxhDKq8Am3427",
+					AccountOwnerName:    "Abdul Hamid",
+					BankCode:            "bca",
+					Amount:              durianpay.NewAmount(10000),
+					AccountNumber:       "8422647",
+					EmailRecipient:      "abdul.surel@gmail.com",
+					PhoneNumber:         "081234567890",
+					Status:              "failed",
+					Notes:               "test notes",
+					CreatedAt:           tests.StringToTime("2023-08-23T10:00:00Z"),
+					UpdatedAt:           tests.StringToTime("2023-08-23T10:05:00Z"),
+					FailureReson:        "invalid account number",
+				},
+			},
+		},
+		{
+			name:     "Virtual account paid",
+			body:     featureWrap.ResJSONByte(pathPayloadWebhook + "virtual_account_paid.json"),
+			wantType: EventVirtualAccountPaid,
+			wantData: &VirtualAccountEvent{
+				VirtualAccount: virtualaccount.VirtualAccount{
+					ID:            "va_8hVcTMJ2rY1234",
+					BankCode:      "BCA",
+					AccountNumber: "1234567890",
+					Name:          "Name Appear in ATM",
+					IsClosed:      true,
+					Amount:        durianpay.NewAmount(15000),
+					Currency:      "IDR",
+					CustomerID:    "cus_KqWm9WjvW91234",
+					IsPaid:        true,
+					VaRefID:       "va_ref_123",
+				},
+				PaymentID:  "pay_aB12Cd34Ef5678",
+				PaidAmount: durianpay.NewAmount(15000),
+				PaidAt:     tests.StringToTime("2023-08-29T15:00:00Z"),
+			},
+		},
+		{
+			name:     "Unknown event keeps raw data",
+			body:     featureWrap.ResJSONByte(pathPayloadWebhook + "unknown.json"),
+			wantType: "subscription.renewed",
+			wantData: json.RawMessage("{\n    \"id\": \"sub_123\"\n  }"),
+		},
+		{
+			name:    "Invalid JSON",
+			body:    []byte("<html></html>"),
+			wantErr: ErrInvalidPayload,
+		},
+		{
+			name:    "Missing event",
+			body:    []byte(`{"data":{}}`),
+			wantErr: ErrInvalidPayload,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseEvent(tt.body)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseEvent() err = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			if got.Type != tt.wantType {
+				t.Errorf("ParseEvent() Type = %v, want %v", got.Type, tt.wantType)
+			}
+
+			if !reflect.DeepEqual(got.Data, tt.wantData) {
+				t.Errorf("ParseEvent() Data = %v, want %v", got.Data, tt.wantData)
+			}
+		})
+	}
+}
+
+func TestPaymentEvent_VerifySignature(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := event.Data.(*PaymentEvent)
+	if !data.VerifySignature(featureWrap.ServerKey) {
+		t.Errorf("PaymentEvent.VerifySignature() = false, want true")
+	}
+
+	if data.VerifySignature("dpay_live_xxx") {
+		t.Errorf("PaymentEvent.VerifySignature() with different key = true, want false")
+	}
+}
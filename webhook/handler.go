@@ -0,0 +1,124 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+)
+
+type contextKey struct{}
+
+// requestContextKey is context key of *http.Request received by Handler
+var requestContextKey = contextKey{}
+
+// defaultMaxBodyBytes is maximum webhook body read by Handler if MaxBodyBytes is not set
+const defaultMaxBodyBytes = 1 << 20
+
+// HandlerFunc processes a parsed webhook event.
+type HandlerFunc func(ctx context.Context, event *Event) error
+
+// Handler is http.Handler for receiving DurianPay webhooks.
+//
+// Handler responds 200 when OnEvent returns nil, 400 when the body is not a valid event (ErrInvalidPayload),
+// 401 for ErrUnauthorized, 409 for ErrEventInFlight and 500 for other errors so DurianPay will retry the webhook.
+// Error of OnEvent is not written to the response, use OnError to log it.
+type Handler struct {
+	OnEvent      HandlerFunc
+	OnError      func(r *http.Request, event *Event, err error) // Called with error returned by OnEvent
+	MaxBodyBytes int64                                          // Default 1MB
+}
+
+// NewHandler returns Handler which calls onEvent for every webhook received.
+func NewHandler(onEvent HandlerFunc) *Handler {
+	return &Handler{
+		OnEvent: onEvent,
+	}
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		writeJSON(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	maxBodyBytes := h.MaxBodyBytes
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = defaultMaxBodyBytes
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, "unable to read body")
+		return
+	}
+
+	event, err := ParseEvent(body)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if h.OnEvent != nil {
+		ctx := context.WithValue(r.Context(), requestContextKey, r)
+		if err := h.OnEvent(ctx, event); err != nil {
+			if h.OnError != nil {
+				h.OnError(r, event, err)
+			}
+
+			code := statusCode(err)
+			writeJSON(w, code, statusMessage(code))
+			return
+		}
+	}
+
+	writeJSON(w, http.StatusOK, "success")
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(map[string]string{"message": message})
+}
+
+// RequestFromContext returns *http.Request of the webhook, available for HandlerFunc called by Handler.
+func RequestFromContext(ctx context.Context) (*http.Request, bool) {
+	r, ok := ctx.Value(requestContextKey).(*http.Request)
+	return r, ok
+}
+
+// statusCode returns http status code for error returned by HandlerFunc.
+func statusCode(err error) int {
+	switch {
+	case errors.Is(err, ErrUnauthorized):
+		return http.StatusUnauthorized
+	case errors.Is(err, ErrInvalidPayload):
+		return http.StatusBadRequest
+	case errors.Is(err, ErrEventInFlight):
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
+
+// statusMessage returns message of response for error returned by HandlerFunc, the error itself may contain internals.
+func statusMessage(statusCode int) string {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return "unauthorized"
+	case http.StatusBadRequest:
+		return "invalid payload"
+	case http.StatusConflict:
+		return "event is being processed"
+	}
+
+	return "internal error"
+}
@@ -0,0 +1,123 @@
+/*
+ * File Created: Monday, 19th October 2026 12:23:59 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestHandler_ServeHTTP(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		method         string
+		body           []byte
+		onEvent        HandlerFunc
+		wantStatusCode int
+		wantMessage    string
+		wantCalled     bool
+		wantOnError    bool
+	}{
+		{
+			name:   "Success",
+			method: http.MethodPost,
+			body:   featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"),
+			onEvent: func(ctx context.Context, event *Event) error {
+				if _, ok := event.Data.(*PaymentEvent); !ok {
+					return errors.New("invalid data")
+				}
+				return nil
+			},
+			wantStatusCode: http.StatusOK,
+			wantMessage:    "success",
+			wantCalled:     true,
+		},
+		{
+			name:   "Handler error, DurianPay should retry",
+			method: http.MethodPost,
+			body:   featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"),
+			onEvent: func(ctx context.Context, event *Event) error {
+				return errors.New("database down")
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantMessage:    "internal error",
+			wantCalled:     true,
+			wantOnError:    true,
+		},
+		{
+			name:   "Unauthorized",
+			method: http.MethodPost,
+			body:   featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"),
+			onEvent: func(ctx context.Context, event *Event) error {
+				return fmt.Errorf("%w: invalid signature for payment pay_1", ErrUnauthorized)
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantMessage:    "unauthorized",
+			wantCalled:     true,
+			wantOnError:    true,
+		},
+		{
+			name:           "Invalid payload",
+			method:         http.MethodPost,
+			body:           []byte("not json"),
+			wantStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:           "Method not allowed",
+			method:         http.MethodGet,
+			wantStatusCode: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called, onError := false, false
+			h := NewHandler(func(ctx context.Context, event *Event) error {
+				called = true
+				if tt.onEvent == nil {
+					return nil
+				}
+				return tt.onEvent(ctx, event)
+			})
+			h.OnError = func(r *http.Request, event *Event, err error) {
+				onError = event != nil && err != nil
+			}
+
+			rec := httptest.NewRecorder()
+			h.ServeHTTP(rec, httptest.NewRequest(tt.method, "/webhook", bytes.NewReader(tt.body)))
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("Handler.ServeHTTP() status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			if called != tt.wantCalled {
+				t.Errorf("Handler.ServeHTTP() called = %v, want %v", called, tt.wantCalled)
+			}
+
+			if onError != tt.wantOnError {
+				t.Errorf("Handler.ServeHTTP() OnError called = %v, want %v", onError, tt.wantOnError)
+			}
+
+			var res map[string]string
+			json.Unmarshal(rec.Body.Bytes(), &res)
+			if tt.wantMessage != "" && res["message"] != tt.wantMessage {
+				t.Errorf("Handler.ServeHTTP() message = %q, want %q", res["message"], tt.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,82 @@
+/*
+ * File Created: Monday, 19th October 2026 12:25:32 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"runtime/debug"
+	"time"
+)
+
+// ErrUnauthorized is returned by middleware when the webhook cannot be trusted, Handler responds 401.
+var ErrUnauthorized = errors.New("webhook: unauthorized")
+
+// Middleware wraps HandlerFunc, ex: for logging, panic recovery or authorization.
+type Middleware func(next HandlerFunc) HandlerFunc
+
+// Recover returns middleware which converts panic from next handler into error,
+// so the webhook is responded with 500 and retried by DurianPay.
+// The panic & stack trace are logged to logger, the returned error only has the event type.
+// If logger is nil log.Default() is used.
+func Recover(logger *log.Logger) Middleware {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, event *Event) (err error) {
+			defer func() {
+				if r := recover(); r != nil {
+					logger.Printf("webhook: panic on event %s: %v\n%s", event.Type, r, debug.Stack())
+					err = fmt.Errorf("webhook: panic on event %s", event.Type)
+				}
+			}()
+
+			return next(ctx, event)
+		}
+	}
+}
+
+// Logging returns middleware which logs event type, duration and error of every event.
+// If logger is nil log.Default() is used.
+func Logging(logger *log.Logger) Middleware {
+	if logger == nil {
+		logger = log.Default()
+	}
+
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, event *Event) error {
+			start := time.Now()
+			err := next(ctx, event)
+			if err != nil {
+				logger.Printf("webhook: event=%s duration=%s error=%v", event.Type, time.Since(start), err)
+				return err
+			}
+
+			logger.Printf("webhook: event=%s duration=%s", event.Type, time.Since(start))
+
+			return nil
+		}
+	}
+}
+
+// RequirePaymentSignature returns middleware which rejects payment events with invalid signature
+// using ErrUnauthorized, see PaymentEvent.VerifySignature.
+func RequirePaymentSignature(serverKey string) Middleware {
+	return func(next HandlerFunc) HandlerFunc {
+		return func(ctx context.Context, event *Event) error {
+			if data, ok := event.Data.(*PaymentEvent); ok && !data.VerifySignature(serverKey) {
+				return fmt.Errorf("%w: invalid signature for payment %s", ErrUnauthorized, data.ID)
+			}
+
+			return next(ctx, event)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+/*
+ * File Created: Monday, 19th October 2026 12:25:32 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestMiddleware(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	tests := []struct {
+		name           string
+		middlewares    func(logs *bytes.Buffer) []Middleware
+		handler        func(ctx context.Context, event PaymentEvent) error
+		wantStatusCode int
+		wantLog        string
+	}{
+		{
+			name: "Recover from panic",
+			middlewares: func(logs *bytes.Buffer) []Middleware {
+				return []Middleware{Recover(log.New(logs, "", 0))}
+			},
+			handler: func(ctx context.Context, event PaymentEvent) error {
+				panic("nil map")
+			},
+			wantStatusCode: http.StatusInternalServerError,
+			wantLog:        "webhook: panic on event payment.completed: nil map\ngoroutine",
+		},
+		{
+			name: "Logging",
+			middlewares: func(logs *bytes.Buffer) []Middleware {
+				return []Middleware{Logging(log.New(logs, "", 0))}
+			},
+			handler: func(ctx context.Context, event PaymentEvent) error {
+				if _, ok := RequestFromContext(ctx); !ok {
+					t.Error("RequestFromContext() ok = false, want true")
+				}
+				return nil
+			},
+			wantStatusCode: http.StatusOK,
+			wantLog:        "webhook: event=payment.completed",
+		},
+		{
+			name: "Valid payment signature",
+			middlewares: func(logs *bytes.Buffer) []Middleware {
+				return []Middleware{RequirePaymentSignature(featureWrap.ServerKey)}
+			},
+			handler: func(ctx context.Context, event PaymentEvent) error {
+				return nil
+			},
+			wantStatusCode: http.StatusOK,
+		},
+		{
+			name: "Invalid payment signature",
+			middlewares: func(logs *bytes.Buffer) []Middleware {
+				return []Middleware{RequirePaymentSignature("dpay_live_xxx")}
+			},
+			handler: func(ctx context.Context, event PaymentEvent) error {
+				t.Error("handler should not be called")
+				return nil
+			},
+			wantStatusCode: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs := &bytes.Buffer{}
+
+			d := NewDispatcher()
+			d.Use(tt.middlewares(logs)...)
+			d.OnPaymentCompleted(tt.handler)
+
+			rec := httptest.NewRecorder()
+			body := featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json")
+			d.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+
+			if rec.Code != tt.wantStatusCode {
+				t.Errorf("status code = %v, want %v", rec.Code, tt.wantStatusCode)
+			}
+
+			if !strings.Contains(logs.String(), tt.wantLog) {
+				t.Errorf("log = %v, want contains %v", logs.String(), tt.wantLog)
+			}
+		})
+	}
+}
+
+func TestRecover(t *testing.T) {
+	logs := &bytes.Buffer{}
+	handler := Recover(log.New(logs, "", 0))(func(ctx context.Context, event *Event) error {
+		panic("nil map")
+	})
+
+	err := handler(context.Background(), &Event{Type: EventPaymentCompleted})
+	if err == nil || err.Error() != "webhook: panic on event payment.completed" {
+		t.Errorf("Recover() error = %v, want error without panic value & stack", err)
+	}
+
+	if !strings.Contains(logs.String(), "runtime/debug.Stack") {
+		t.Errorf("Recover() log = %v, want stack trace", logs.String())
+	}
+}
@@ -0,0 +1,554 @@
+/*
+ * File Created: Monday, 19th October 2026 12:28:38 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"container/heap"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrQueueFull is returned by Queue.Enqueue when the buffer is full, Handler responds 500 so DurianPay will retry.
+	ErrQueueFull = errors.New("webhook: queue is full")
+	// ErrQueueStopped is returned by Queue.Enqueue when the queue is not running.
+	ErrQueueStopped = errors.New("webhook: queue is not running")
+	// ErrDeadLetterNotFound is returned by Queue.Replay when the dead letter does not exist.
+	ErrDeadLetterNotFound = errors.New("webhook: dead letter not found")
+)
+
+const (
+	defaultQueueWorkers     = 4
+	defaultQueueMaxAttempts = 5
+	defaultQueueBufferSize  = 1024
+)
+
+// QueuedEvent is an event stored by Queue.
+type QueuedEvent struct {
+	ID            string
+	Event         *Event
+	Attempts      int
+	LastError     string
+	EnqueuedAt    time.Time
+	NextAttemptAt time.Time
+}
+
+// QueueStore persists events which are not processed yet.
+// Stored events are loaded again by Queue.Start, so events survive restart with a durable store.
+type QueueStore interface {
+	Save(ctx context.Context, item QueuedEvent) error
+	Delete(ctx context.Context, id string) error
+	Pending(ctx context.Context) ([]QueuedEvent, error)
+}
+
+// DeadLetterStore stores events which still fail after Queue.MaxAttempts.
+type DeadLetterStore interface {
+	Put(ctx context.Context, item QueuedEvent) error
+	Get(ctx context.Context, id string) (*QueuedEvent, error) // Returns nil if not found
+	List(ctx context.Context) ([]QueuedEvent, error)
+	Delete(ctx context.Context, id string) error
+}
+
+// Queue processes webhook events asynchronously.
+// Use Queue.Enqueue as Handler.OnEvent, so the webhook is persisted & acknowledged quickly
+// and Handler (ex: Dispatcher.Dispatch) is called by worker goroutines with retry and backoff.
+type Queue struct {
+	Handler     HandlerFunc
+	Store       QueueStore                      // Default MemoryQueueStore, use FileQueueStore to keep events across restart
+	DeadLetter  DeadLetterStore                 // Default MemoryDeadLetterStore, use FileDeadLetterStore to keep dead letters across restart
+	Workers     int                             // Default 4
+	MaxAttempts int                             // Default 5
+	BufferSize  int                             // Default 1024
+	Backoff     func(attempt int) time.Duration // Default ExponentialBackoff(time.Second, 5*time.Minute)
+	// OnStoreError is called when Store or DeadLetter fails while a worker processes item. Default logs with log.Default()
+	OnStoreError func(item QueuedEvent, err error)
+
+	mu      sync.Mutex
+	items   chan QueuedEvent
+	stop    chan struct{}
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	running bool
+
+	// Events waiting for NextAttemptAt, sent to items by one scheduler goroutine
+	delayMu sync.Mutex
+	delayed queuedEventHeap
+	wake    chan struct{}
+}
+
+// NewQueue returns Queue with default options which calls handler for every event.
+func NewQueue(handler HandlerFunc) *Queue {
+	return &Queue{
+		Handler: handler,
+	}
+}
+
+// ExponentialBackoff returns backoff which doubles from initial for every attempt up to max.
+func ExponentialBackoff(initial, max time.Duration) func(attempt int) time.Duration {
+	return func(attempt int) time.Duration {
+		delay := initial
+		for i := 1; i < attempt && delay < max; i++ {
+			delay *= 2
+		}
+
+		if delay > max {
+			return max
+		}
+
+		return delay
+	}
+}
+
+// Start loads pending events from Store and starts the workers.
+// Workers stop when ctx is done or Stop is called.
+func (q *Queue) Start(ctx context.Context) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.running {
+		return nil
+	}
+
+	q.setDefaults()
+
+	pending, err := q.Store.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	bufferSize := q.BufferSize
+	if len(pending) > bufferSize {
+		bufferSize = len(pending)
+	}
+
+	ctx, q.cancel = context.WithCancel(ctx)
+	q.items = make(chan QueuedEvent, bufferSize)
+	q.stop = make(chan struct{})
+	q.wake = make(chan struct{}, 1)
+	q.running = true
+
+	q.delayMu.Lock()
+	q.delayed = nil
+	q.delayMu.Unlock()
+
+	for _, item := range pending {
+		q.schedule(item)
+	}
+
+	q.wg.Add(1)
+	go q.scheduler()
+
+	for i := 0; i < q.Workers; i++ {
+		q.wg.Add(1)
+		go q.work(ctx)
+	}
+
+	return nil
+}
+
+// Stop stops the workers and waits for events being processed.
+// Events waiting for retry stay in Store and are processed on the next Start.
+func (q *Queue) Stop() {
+	q.mu.Lock()
+	if !q.running {
+		q.mu.Unlock()
+		return
+	}
+
+	q.running = false
+	close(q.stop)
+	q.cancel()
+	q.mu.Unlock()
+
+	q.wg.Wait()
+}
+
+// Enqueue persists event into Store and queues it for the workers, it has the signature of HandlerFunc.
+// Store is called without holding the lock of Queue, so a slow store does not block other calls.
+func (q *Queue) Enqueue(ctx context.Context, event *Event) error {
+	q.mu.Lock()
+	running, store, items := q.running, q.Store, q.items
+	q.mu.Unlock()
+
+	if !running {
+		return ErrQueueStopped
+	}
+
+	item := QueuedEvent{
+		ID:         newQueueID(),
+		Event:      event,
+		EnqueuedAt: time.Now(),
+	}
+
+	if err := store.Save(ctx, item); err != nil {
+		return err
+	}
+
+	select {
+	case items <- item:
+		return nil
+	default:
+		if err := store.Delete(ctx, item.ID); err != nil {
+			// The event stays in Store and is processed again on the next Start
+			return errors.Join(ErrQueueFull, err)
+		}
+
+		return ErrQueueFull
+	}
+}
+
+// DeadLetters returns events which failed after MaxAttempts.
+func (q *Queue) DeadLetters(ctx context.Context) ([]QueuedEvent, error) {
+	q.mu.Lock()
+	q.setDefaults()
+	q.mu.Unlock()
+
+	return q.DeadLetter.List(ctx)
+}
+
+// Replay moves dead letter with id back to the queue with attempts reset.
+func (q *Queue) Replay(ctx context.Context, id string) error {
+	q.mu.Lock()
+	running := q.running
+	q.mu.Unlock()
+
+	if !running {
+		return ErrQueueStopped
+	}
+
+	item, err := q.DeadLetter.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if item == nil {
+		return ErrDeadLetterNotFound
+	}
+
+	item.Attempts = 0
+	item.LastError = ""
+	item.NextAttemptAt = time.Time{}
+
+	if err := q.Store.Save(ctx, *item); err != nil {
+		return err
+	}
+
+	if err := q.DeadLetter.Delete(ctx, id); err != nil {
+		return err
+	}
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	// Stopped meanwhile, the event is loaded from Store on the next Start
+	if q.running {
+		q.schedule(*item)
+	}
+
+	return nil
+}
+
+func (q *Queue) setDefaults() {
+	if q.Store == nil {
+		q.Store = NewMemoryQueueStore()
+	}
+
+	if q.DeadLetter == nil {
+		q.DeadLetter = NewMemoryDeadLetterStore()
+	}
+
+	if q.Workers <= 0 {
+		q.Workers = defaultQueueWorkers
+	}
+
+	if q.MaxAttempts <= 0 {
+		q.MaxAttempts = defaultQueueMaxAttempts
+	}
+
+	if q.BufferSize <= 0 {
+		q.BufferSize = defaultQueueBufferSize
+	}
+
+	if q.Backoff == nil {
+		q.Backoff = ExponentialBackoff(time.Second, 5*time.Minute)
+	}
+
+	if q.OnStoreError == nil {
+		q.OnStoreError = func(item QueuedEvent, err error) {
+			log.Printf("webhook: queue store failed for event %s (%s): %v", item.ID, item.Event.Type, err)
+		}
+	}
+}
+
+func (q *Queue) work(ctx context.Context) {
+	defer q.wg.Done()
+
+	for {
+		select {
+		case <-q.stop:
+			return
+		case item := <-q.items:
+			q.process(ctx, item)
+		}
+	}
+}
+
+func (q *Queue) process(ctx context.Context, item QueuedEvent) {
+	item.Attempts++
+
+	err := q.handle(ctx, item.Event)
+	if err == nil {
+		// On failure the event stays in Store and is processed again on the next Start
+		q.storeError(item, q.Store.Delete(ctx, item.ID))
+		return
+	}
+
+	if ctx.Err() != nil {
+		// Stopped while processing, the event stays in Store for the next Start
+		return
+	}
+
+	item.LastError = err.Error()
+
+	if item.Attempts >= q.MaxAttempts {
+		err := q.DeadLetter.Put(ctx, item)
+		if err == nil {
+			q.storeError(item, q.Store.Delete(ctx, item.ID))
+			return
+		}
+
+		// Not moved to DeadLetter, keep retrying so the event is not lost
+		q.storeError(item, err)
+	}
+
+	item.NextAttemptAt = time.Now().Add(q.Backoff(item.Attempts))
+	q.storeError(item, q.Store.Save(ctx, item))
+	q.schedule(item)
+}
+
+// storeError reports err of Store or DeadLetter to OnStoreError.
+func (q *Queue) storeError(item QueuedEvent, err error) {
+	if err != nil {
+		q.OnStoreError(item, err)
+	}
+}
+
+// handle calls Handler and converts panic into error.
+func (q *Queue) handle(ctx context.Context, event *Event) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("webhook: panic on event %s: %v", event.Type, r)
+		}
+	}()
+
+	return q.Handler(ctx, event)
+}
+
+// schedule sends item to the workers, item is delayed until NextAttemptAt or until the buffer has room.
+func (q *Queue) schedule(item QueuedEvent) {
+	if !item.NextAttemptAt.After(time.Now()) {
+		select {
+		case q.items <- item:
+			return
+		default:
+		}
+	}
+
+	q.delayMu.Lock()
+	heap.Push(&q.delayed, item)
+	q.delayMu.Unlock()
+
+	select {
+	case q.wake <- struct{}{}:
+	default:
+	}
+}
+
+// scheduler sends delayed events to the workers in NextAttemptAt order, waiting with a single timer.
+func (q *Queue) scheduler() {
+	defer q.wg.Done()
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		q.delayMu.Lock()
+		var (
+			item  QueuedEvent
+			due   bool
+			delay time.Duration = -1
+		)
+		if len(q.delayed) > 0 {
+			if delay = time.Until(q.delayed[0].NextAttemptAt); delay <= 0 {
+				item, due = heap.Pop(&q.delayed).(QueuedEvent), true
+			}
+		}
+		q.delayMu.Unlock()
+
+		if due {
+			select {
+			case <-q.stop:
+				return
+			case q.items <- item:
+			}
+
+			continue
+		}
+
+		var wait <-chan time.Time
+		if delay > 0 {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+			timer.Reset(delay)
+			wait = timer.C
+		}
+
+		select {
+		case <-q.stop:
+			return
+		case <-q.wake:
+		case <-wait:
+		}
+	}
+}
+
+// queuedEventHeap is a heap of events ordered by NextAttemptAt.
+type queuedEventHeap []QueuedEvent
+
+func (h queuedEventHeap) Len() int { return len(h) }
+
+func (h queuedEventHeap) Less(i, j int) bool {
+	return h[i].NextAttemptAt.Before(h[j].NextAttemptAt)
+}
+
+func (h queuedEventHeap) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *queuedEventHeap) Push(x any) { *h = append(*h, x.(QueuedEvent)) }
+
+func (h *queuedEventHeap) Pop() any {
+	old := *h
+	item := old[len(old)-1]
+	*h = old[:len(old)-1]
+
+	return item
+}
+
+func newQueueID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
+
+// MemoryQueueStore is in-memory QueueStore, events are lost when the process exits.
+type MemoryQueueStore struct {
+	mu    sync.Mutex
+	items map[string]QueuedEvent
+}
+
+// NewMemoryQueueStore returns empty MemoryQueueStore.
+func NewMemoryQueueStore() *MemoryQueueStore {
+	return &MemoryQueueStore{items: map[string]QueuedEvent{}}
+}
+
+func (s *MemoryQueueStore) Save(ctx context.Context, item QueuedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[item.ID] = item
+
+	return nil
+}
+
+func (s *MemoryQueueStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, id)
+
+	return nil
+}
+
+func (s *MemoryQueueStore) Pending(ctx context.Context) ([]QueuedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedQueuedEvents(s.items), nil
+}
+
+// MemoryDeadLetterStore is in-memory DeadLetterStore.
+type MemoryDeadLetterStore struct {
+	mu    sync.Mutex
+	items map[string]QueuedEvent
+}
+
+// NewMemoryDeadLetterStore returns empty MemoryDeadLetterStore.
+func NewMemoryDeadLetterStore() *MemoryDeadLetterStore {
+	return &MemoryDeadLetterStore{items: map[string]QueuedEvent{}}
+}
+
+func (s *MemoryDeadLetterStore) Put(ctx context.Context, item QueuedEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.items[item.ID] = item
+
+	return nil
+}
+
+func (s *MemoryDeadLetterStore) Get(ctx context.Context, id string) (*QueuedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	item, ok := s.items[id]
+	if !ok {
+		return nil, nil
+	}
+
+	return &item, nil
+}
+
+func (s *MemoryDeadLetterStore) List(ctx context.Context) ([]QueuedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return sortedQueuedEvents(s.items), nil
+}
+
+func (s *MemoryDeadLetterStore) Delete(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.items, id)
+
+	return nil
+}
+
+// sortedQueuedEvents returns items ordered by EnqueuedAt.
+func sortedQueuedEvents(items map[string]QueuedEvent) []QueuedEvent {
+	result := make([]QueuedEvent, 0, len(items))
+	for _, item := range items {
+		result = append(result, item)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].EnqueuedAt.Before(result[j].EnqueuedAt)
+	})
+
+	return result
+}
@@ -0,0 +1,248 @@
+/*
+ * File Created: Monday, 19th October 2026 2:40:12 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// fileQueuedEvent is QueuedEvent stored as JSON, Event is stored as its raw body and parsed again when loaded.
+type fileQueuedEvent struct {
+	ID            string          `json:"id"`
+	Raw           json.RawMessage `json:"raw"`
+	Synthetic     bool            `json:"synthetic"`
+	Attempts      int             `json:"attempts"`
+	LastError     string          `json:"last_error"`
+	EnqueuedAt    time.Time       `json:"enqueued_at"`
+	NextAttemptAt time.Time       `json:"next_attempt_at"`
+}
+
+// fileQueueDir stores every QueuedEvent as "<id>.json" in a directory.
+// A file is written to a temporary file which replaces it, so a crash keeps either the old or the new file.
+type fileQueueDir struct {
+	mu  sync.Mutex
+	dir string
+}
+
+func openFileQueueDir(dir string) (*fileQueueDir, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, err
+	}
+
+	return &fileQueueDir{dir: dir}, nil
+}
+
+func (d *fileQueueDir) path(id string) (string, error) {
+	if id == "" || strings.ContainsAny(id, `/\.`) {
+		return "", fmt.Errorf("webhook: invalid queue id %q", id)
+	}
+
+	return filepath.Join(d.dir, id+".json"), nil
+}
+
+func (d *fileQueueDir) save(item QueuedEvent) error {
+	path, err := d.path(item.ID)
+	if err != nil {
+		return err
+	}
+
+	if item.Event == nil || len(item.Event.Raw) == 0 {
+		return fmt.Errorf("webhook: queued event %s has no raw body", item.ID)
+	}
+
+	b, err := json.Marshal(fileQueuedEvent{
+		ID:            item.ID,
+		Raw:           item.Event.Raw,
+		Synthetic:     item.Event.Synthetic,
+		Attempts:      item.Attempts,
+		LastError:     item.LastError,
+		EnqueuedAt:    item.EnqueuedAt,
+		NextAttemptAt: item.NextAttemptAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	tmp, err := os.CreateTemp(d.dir, item.ID+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // No-op after rename
+
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (d *fileQueueDir) get(id string) (*QueuedEvent, error) {
+	path, err := d.path(id)
+	if err != nil {
+		return nil, err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	b, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeFileQueuedEvent(b)
+}
+
+func (d *fileQueueDir) delete(id string) error {
+	path, err := d.path(id)
+	if err != nil {
+		return err
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// list returns every stored event ordered by EnqueuedAt.
+func (d *fileQueueDir) list() ([]QueuedEvent, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	paths, err := filepath.Glob(filepath.Join(d.dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	items := map[string]QueuedEvent{}
+	for _, path := range paths {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		item, err := decodeFileQueuedEvent(b)
+		if err != nil {
+			return nil, fmt.Errorf("webhook: %s: %w", filepath.Base(path), err)
+		}
+
+		items[item.ID] = *item
+	}
+
+	return sortedQueuedEvents(items), nil
+}
+
+func decodeFileQueuedEvent(b []byte) (*QueuedEvent, error) {
+	var stored fileQueuedEvent
+	if err := json.Unmarshal(b, &stored); err != nil {
+		return nil, err
+	}
+
+	event, err := ParseEvent(stored.Raw)
+	if err != nil {
+		return nil, err
+	}
+	event.Synthetic = stored.Synthetic
+
+	return &QueuedEvent{
+		ID:            stored.ID,
+		Event:         event,
+		Attempts:      stored.Attempts,
+		LastError:     stored.LastError,
+		EnqueuedAt:    stored.EnqueuedAt,
+		NextAttemptAt: stored.NextAttemptAt,
+	}, nil
+}
+
+// FileQueueStore is QueueStore persisted to a directory, one JSON file per event, so events survive restart.
+type FileQueueStore struct {
+	files *fileQueueDir
+}
+
+// OpenFileQueueStore opens or creates directory dir for pending events.
+func OpenFileQueueStore(dir string) (*FileQueueStore, error) {
+	files, err := openFileQueueDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileQueueStore{files: files}, nil
+}
+
+func (s *FileQueueStore) Save(ctx context.Context, item QueuedEvent) error {
+	return s.files.save(item)
+}
+
+func (s *FileQueueStore) Delete(ctx context.Context, id string) error {
+	return s.files.delete(id)
+}
+
+func (s *FileQueueStore) Pending(ctx context.Context) ([]QueuedEvent, error) {
+	return s.files.list()
+}
+
+// FileDeadLetterStore is DeadLetterStore persisted to a directory, one JSON file per event.
+// Use a different directory from FileQueueStore.
+type FileDeadLetterStore struct {
+	files *fileQueueDir
+}
+
+// OpenFileDeadLetterStore opens or creates directory dir for dead letters.
+func OpenFileDeadLetterStore(dir string) (*FileDeadLetterStore, error) {
+	files, err := openFileQueueDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &FileDeadLetterStore{files: files}, nil
+}
+
+func (s *FileDeadLetterStore) Put(ctx context.Context, item QueuedEvent) error {
+	return s.files.save(item)
+}
+
+func (s *FileDeadLetterStore) Get(ctx context.Context, id string) (*QueuedEvent, error) {
+	return s.files.get(id)
+}
+
+func (s *FileDeadLetterStore) List(ctx context.Context) ([]QueuedEvent, error) {
+	return s.files.list()
+}
+
+func (s *FileDeadLetterStore) Delete(ctx context.Context, id string) error {
+	return s.files.delete(id)
+}
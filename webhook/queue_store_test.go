@@ -0,0 +1,185 @@
+/*
+ * File Created: Monday, 19th October 2026 2:40:12 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+func TestFileQueueStore(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	event.Synthetic = true
+
+	s, err := OpenFileQueueStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	first := QueuedEvent{ID: "a", Event: event, Attempts: 2, LastError: "failed", EnqueuedAt: now, NextAttemptAt: now.Add(time.Minute)}
+	second := QueuedEvent{ID: "b", Event: event, EnqueuedAt: now.Add(time.Second)}
+
+	for _, item := range []QueuedEvent{second, first} {
+		if err := s.Save(ctx, item); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := s.Save(ctx, QueuedEvent{ID: "../c", Event: event}); err == nil {
+		t.Errorf("Save() with invalid id err = nil, want error")
+	}
+
+	// Reopen to make sure the events are loaded from disk
+	s, err = OpenFileQueueStore(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pending, err := s.Pending(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(pending) != 2 || pending[0].ID != "a" || pending[1].ID != "b" {
+		t.Fatalf("Pending() = %v, want [a b]", pending)
+	}
+
+	got := pending[0]
+	if got.Attempts != first.Attempts || got.LastError != first.LastError ||
+		!got.EnqueuedAt.Equal(first.EnqueuedAt) || !got.NextAttemptAt.Equal(first.NextAttemptAt) {
+		t.Errorf("Pending()[0] = %+v, want %+v", got, first)
+	}
+
+	if got.Event.Type != event.Type || !got.Event.Synthetic || !reflect.DeepEqual(got.Event.Data, event.Data) {
+		t.Errorf("Pending()[0].Event = %+v, want %+v", got.Event, event)
+	}
+
+	if err := s.Delete(ctx, "a"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Delete(ctx, "a"); err != nil {
+		t.Errorf("Delete() of missing id err = %v, want nil", err)
+	}
+
+	if pending, _ := s.Pending(ctx); len(pending) != 1 {
+		t.Errorf("Pending() after Delete = %v, want 1 event", pending)
+	}
+
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Errorf("files = %v, want 1 without temporary files", entries)
+	}
+}
+
+func TestFileDeadLetterStore(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	ctx := context.Background()
+	event, _ := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+
+	s, err := OpenFileDeadLetterStore(filepath.Join(t.TempDir(), "dead"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item, err := s.Get(ctx, "a"); item != nil || err != nil {
+		t.Errorf("Get() of missing id = %v, %v, want nil, nil", item, err)
+	}
+
+	if err := s.Put(ctx, QueuedEvent{ID: "a", Event: event, Attempts: 5}); err != nil {
+		t.Fatal(err)
+	}
+
+	item, err := s.Get(ctx, "a")
+	if err != nil || item == nil || item.Attempts != 5 {
+		t.Fatalf("Get() = %v, %v, want event with 5 attempts", item, err)
+	}
+
+	if list, _ := s.List(ctx); len(list) != 1 {
+		t.Errorf("List() = %v, want 1 event", list)
+	}
+
+	s.Delete(ctx, "a")
+	if list, _ := s.List(ctx); len(list) != 0 {
+		t.Errorf("List() after Delete = %v, want empty", list)
+	}
+}
+
+func TestQueue_FileStore(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	event, _ := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+
+	// First process stops before the delayed retry
+	store, _ := OpenFileQueueStore(dir)
+	q := NewQueue(func(ctx context.Context, event *Event) error {
+		return context.DeadlineExceeded
+	})
+	q.Store = store
+	q.Backoff = func(attempt int) time.Duration { return time.Hour }
+
+	if err := q.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := q.Enqueue(ctx, event); err != nil {
+		t.Fatal(err)
+	}
+
+	waitFor(t, func() bool {
+		pending, _ := store.Pending(ctx)
+		return len(pending) == 1 && pending[0].Attempts == 1
+	})
+	q.Stop()
+
+	// Second process loads the event from the directory
+	store, _ = OpenFileQueueStore(dir)
+	var calls atomic.Int32
+	q = NewQueue(func(ctx context.Context, got *Event) error {
+		if got.Type == event.Type {
+			calls.Add(1)
+		}
+		return nil
+	})
+	q.Store = store
+
+	pending, _ := store.Pending(ctx)
+	for _, item := range pending {
+		item.NextAttemptAt = time.Time{}
+		store.Save(ctx, item)
+	}
+
+	if err := q.Start(ctx); err != nil {
+		t.Fatal(err)
+	}
+	defer q.Stop()
+
+	waitFor(t, func() bool {
+		pending, _ := store.Pending(ctx)
+		return calls.Load() == 1 && len(pending) == 0
+	})
+}
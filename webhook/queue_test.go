@@ -0,0 +1,325 @@
+/*
+ * File Created: Monday, 19th October 2026 12:28:38 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+)
+
+// waitFor polls cond until true or fails the test after one second.
+func waitFor(t *testing.T, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatal("condition not met before deadline")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestQueue(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	body := featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json")
+
+	t.Run("Acknowledge quickly and retry until success", func(t *testing.T) {
+		var calls atomic.Int32
+		store := NewMemoryQueueStore()
+
+		q := NewQueue(func(ctx context.Context, event *Event) error {
+			if calls.Add(1) < 3 {
+				return errors.New("temporary failure")
+			}
+			return nil
+		})
+		q.Store = store
+		q.Backoff = func(attempt int) time.Duration { return time.Millisecond }
+
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		rec := httptest.NewRecorder()
+		NewHandler(q.Enqueue).ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/webhook", bytes.NewReader(body)))
+		if rec.Code != http.StatusOK {
+			t.Errorf("status code = %v, want %v", rec.Code, http.StatusOK)
+		}
+
+		waitFor(t, func() bool {
+			pending, _ := store.Pending(context.Background())
+			return calls.Load() == 3 && len(pending) == 0
+		})
+	})
+
+	t.Run("Dead letter and replay", func(t *testing.T) {
+		var fail atomic.Bool
+		var calls atomic.Int32
+		fail.Store(true)
+
+		q := NewQueue(func(ctx context.Context, event *Event) error {
+			calls.Add(1)
+			if fail.Load() {
+				panic("poison event")
+			}
+			return nil
+		})
+		q.MaxAttempts = 2
+		q.Backoff = func(attempt int) time.Duration { return time.Millisecond }
+
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		event, _ := ParseEvent(body)
+		if err := q.Enqueue(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+
+		var deadLetters []QueuedEvent
+		waitFor(t, func() bool {
+			deadLetters, _ = q.DeadLetters(context.Background())
+			return len(deadLetters) == 1
+		})
+
+		if deadLetters[0].Attempts != 2 || deadLetters[0].LastError == "" {
+			t.Errorf("dead letter = %+v, want 2 attempts with last error", deadLetters[0])
+		}
+
+		fail.Store(false)
+		if err := q.Replay(context.Background(), deadLetters[0].ID); err != nil {
+			t.Fatal(err)
+		}
+
+		waitFor(t, func() bool { return calls.Load() == 3 })
+
+		if err := q.Replay(context.Background(), deadLetters[0].ID); !errors.Is(err, ErrDeadLetterNotFound) {
+			t.Errorf("Replay() err = %v, want %v", err, ErrDeadLetterNotFound)
+		}
+	})
+
+	t.Run("Recover pending events on start", func(t *testing.T) {
+		event, _ := ParseEvent(body)
+		store := NewMemoryQueueStore()
+		store.Save(context.Background(), QueuedEvent{ID: "1", Event: event, Attempts: 1})
+
+		var calls atomic.Int32
+		q := NewQueue(func(ctx context.Context, event *Event) error {
+			calls.Add(1)
+			return nil
+		})
+		q.Store = store
+
+		if err := q.Enqueue(context.Background(), event); !errors.Is(err, ErrQueueStopped) {
+			t.Errorf("Enqueue() before Start err = %v, want %v", err, ErrQueueStopped)
+		}
+
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		waitFor(t, func() bool { return calls.Load() == 1 })
+	})
+
+	t.Run("Delayed events are sent in NextAttemptAt order", func(t *testing.T) {
+		event, _ := ParseEvent(body)
+		now := time.Now()
+		store := NewMemoryQueueStore()
+		store.Save(context.Background(), QueuedEvent{ID: "3", Event: event, EnqueuedAt: now, NextAttemptAt: now.Add(60 * time.Millisecond)})
+		store.Save(context.Background(), QueuedEvent{ID: "1", Event: event, EnqueuedAt: now.Add(time.Millisecond), NextAttemptAt: now.Add(20 * time.Millisecond)})
+		store.Save(context.Background(), QueuedEvent{ID: "2", Event: event, EnqueuedAt: now.Add(2 * time.Millisecond), NextAttemptAt: now.Add(40 * time.Millisecond)})
+
+		var (
+			mu  sync.Mutex
+			ids []string
+		)
+		q := NewQueue(func(ctx context.Context, event *Event) error {
+			return nil
+		})
+		q.Workers = 1
+		// Delete is called after the event is handled, so it records the order
+		q.Store = &testQueueStore{MemoryQueueStore: store, onDelete: func(id string) {
+			mu.Lock()
+			ids = append(ids, id)
+			mu.Unlock()
+		}}
+
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		waitFor(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return len(ids) == 3
+		})
+
+		if want := []string{"1", "2", "3"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("processed = %v, want %v", ids, want)
+		}
+	})
+}
+
+// testQueueStore is QueueStore whose Save can block and Delete can fail or be observed.
+type testQueueStore struct {
+	*MemoryQueueStore
+	saving      chan struct{} // Receives when a blocked Save starts
+	release     chan struct{} // Unblocks the blocked Save
+	blockOnce   atomic.Bool
+	deleteError error
+	onDelete    func(id string)
+}
+
+func (s *testQueueStore) Save(ctx context.Context, item QueuedEvent) error {
+	if s.release != nil && s.blockOnce.CompareAndSwap(false, true) {
+		s.saving <- struct{}{}
+		<-s.release
+	}
+
+	return s.MemoryQueueStore.Save(ctx, item)
+}
+
+func (s *testQueueStore) Delete(ctx context.Context, id string) error {
+	if s.deleteError != nil {
+		return s.deleteError
+	}
+
+	if s.onDelete != nil {
+		s.onDelete(id)
+	}
+
+	return s.MemoryQueueStore.Delete(ctx, id)
+}
+
+// failingDeadLetterStore is DeadLetterStore which cannot store events.
+type failingDeadLetterStore struct {
+	*MemoryDeadLetterStore
+}
+
+func (s failingDeadLetterStore) Put(ctx context.Context, item QueuedEvent) error {
+	return errors.New("dead letter store down")
+}
+
+func TestQueue_Store(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	event, err := ParseEvent(featureWrap.ResJSONByte(pathPayloadWebhook + "payment_completed.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Slow store does not block other calls", func(t *testing.T) {
+		store := &testQueueStore{MemoryQueueStore: NewMemoryQueueStore(), saving: make(chan struct{}), release: make(chan struct{})}
+
+		q := NewQueue(func(ctx context.Context, event *Event) error { return nil })
+		q.Store = store
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		slow := make(chan error)
+		go func() { slow <- q.Enqueue(context.Background(), event) }()
+		<-store.saving
+
+		done := make(chan error)
+		go func() { done <- q.Enqueue(context.Background(), event) }()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Enqueue() err = %v", err)
+			}
+		case <-time.After(time.Second):
+			t.Error("Enqueue() blocked by slow Store.Save of another event")
+		}
+
+		close(store.release)
+		if err := <-slow; err != nil {
+			t.Errorf("Enqueue() slow err = %v", err)
+		}
+	})
+
+	t.Run("Store errors are reported", func(t *testing.T) {
+		store := &testQueueStore{MemoryQueueStore: NewMemoryQueueStore(), deleteError: errors.New("store down")}
+
+		var calls atomic.Int32
+		q := NewQueue(func(ctx context.Context, event *Event) error {
+			if calls.Add(1) < 4 {
+				return errors.New("temporary failure")
+			}
+			return nil
+		})
+		q.Store = store
+		q.DeadLetter = failingDeadLetterStore{NewMemoryDeadLetterStore()}
+		q.MaxAttempts = 2
+		q.Backoff = func(attempt int) time.Duration { return time.Millisecond }
+
+		var reported []string
+		var mu sync.Mutex
+		q.OnStoreError = func(item QueuedEvent, err error) {
+			mu.Lock()
+			defer mu.Unlock()
+			reported = append(reported, err.Error())
+		}
+
+		if err := q.Start(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		defer q.Stop()
+
+		if err := q.Enqueue(context.Background(), event); err != nil {
+			t.Fatal(err)
+		}
+
+		// The event is retried after DeadLetter fails and finally succeeds, but cannot be deleted from Store
+		waitFor(t, func() bool {
+			mu.Lock()
+			defer mu.Unlock()
+			return calls.Load() == 4 && len(reported) == 3
+		})
+
+		mu.Lock()
+		defer mu.Unlock()
+		want := []string{"dead letter store down", "dead letter store down", "store down"}
+		if !reflect.DeepEqual(reported, want) {
+			t.Errorf("OnStoreError() = %v, want %v", reported, want)
+		}
+
+		if pending, _ := store.Pending(context.Background()); len(pending) != 1 {
+			t.Errorf("Store pending = %v, want the event kept for next Start", len(pending))
+		}
+	})
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := ExponentialBackoff(time.Second, 5*time.Second)
+
+	for attempt, want := range map[int]time.Duration{1: time.Second, 2: 2 * time.Second, 3: 4 * time.Second, 4: 5 * time.Second, 10: 5 * time.Second} {
+		if got := backoff(attempt); got != want {
+			t.Errorf("ExponentialBackoff()(%d) = %v, want %v", attempt, got, want)
+		}
+	}
+}
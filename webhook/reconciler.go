@@ -0,0 +1,361 @@
+/*
+ * File Created: Monday, 19th October 2026 12:30:08 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+)
+
+const (
+	defaultReconcilePageSize = 100
+	defaultReconcileOverlap  = 24 * time.Hour
+)
+
+// ReconcileCheckpointStore persists the time of the last successful Reconciler run.
+type ReconcileCheckpointStore interface {
+	Load(ctx context.Context) (time.Time, error) // Returns zero time if there is no checkpoint
+	Save(ctx context.Context, checkpoint time.Time) error
+}
+
+// StateStore stores the last known status of payments, refunds and disbursement items.
+type StateStore interface {
+	Get(ctx context.Context, key string) (status string, ok bool, err error)
+	Set(ctx context.Context, key string, status string) error
+}
+
+// ReconcileResult is summary of a Reconciler run.
+type ReconcileResult struct {
+	Payments          int // Payments scanned
+	Refunds           int // Refunds scanned
+	DisbursementItems int // Disbursement items scanned
+	Events            int // Synthetic events emitted
+	Checkpoint        time.Time
+}
+
+// Reconciler reconstructs webhook events missed while the webhook endpoint was down.
+//
+// Reconciler walks payments & refunds since the checkpoint and items of DisbursementIDs,
+// compares their status with StateStore and calls OnEvent with synthetic events (Event.Synthetic)
+// for every status change. The checkpoint is saved only when all events are handled.
+// List APIs filter by date, not by update time, so the range starts Overlap before the checkpoint
+// to catch payments & refunds created before the checkpoint whose status changed afterwards.
+// Synthetic events have the same EventKey as the real webhook, so Deduplicator drops events already received.
+type Reconciler struct {
+	Payment         *payment.Client
+	Refund          *refund.Client
+	Disbursement    *disbursement.Client
+	DisbursementIDs []string // Disbursements which items are reconciled
+	Checkpoint      ReconcileCheckpointStore
+	State           StateStore
+	OnEvent         HandlerFunc
+	PageSize        uint16        // Default 100
+	Overlap         time.Duration // Default 24 hours
+	InitialLookback time.Duration // Used when there is no checkpoint yet, default 24 hours
+
+	now func() time.Time
+}
+
+// Run reconciles once, see Reconciler.
+func (r *Reconciler) Run(ctx context.Context) (*ReconcileResult, error) {
+	now := time.Now()
+	if r.now != nil {
+		now = r.now()
+	}
+
+	since, err := r.Checkpoint.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if since.IsZero() {
+		lookback := r.InitialLookback
+		if lookback <= 0 {
+			lookback = 24 * time.Hour
+		}
+		since = now.Add(-lookback)
+	} else {
+		overlap := r.Overlap
+		if overlap <= 0 {
+			overlap = defaultReconcileOverlap
+		}
+		since = since.Add(-overlap)
+	}
+
+	result := &ReconcileResult{}
+	dates := durianpay.NewDateRange(since, now)
+
+	if r.Payment != nil {
+		if err := r.reconcilePayments(ctx, dates, result); err != nil {
+			return result, err
+		}
+	}
+
+	if r.Refund != nil {
+		if err := r.reconcileRefunds(ctx, dates, result); err != nil {
+			return result, err
+		}
+	}
+
+	if r.Disbursement != nil {
+		for _, id := range r.DisbursementIDs {
+			if err := r.reconcileDisbursementItems(ctx, id, result); err != nil {
+				return result, err
+			}
+		}
+	}
+
+	if err := r.Checkpoint.Save(ctx, now); err != nil {
+		return result, err
+	}
+
+	result.Checkpoint = now
+
+	return result, nil
+}
+
+func (r *Reconciler) reconcilePayments(ctx context.Context, dates durianpay.DateRange, result *ReconcileResult) error {
+	// Long downtime is fetched window by window by the iterator
+	it := r.Payment.IteratePayments(ctx, durianpay.PaymentFetchOption{
+		Range: dates,
+		Limit: r.pageSize(),
+	})
+
+	for it.Next() {
+		p := it.Item()
+		result.Payments++
+		eventType := paymentEventType(p.Status)
+		if err := r.emit(ctx, "payment:"+p.ID, p.Status, eventType, &PaymentEvent{Payments: p}, result); err != nil {
+			return err
+		}
+	}
+
+	return it.Err().Err()
+}
+
+func (r *Reconciler) reconcileRefunds(ctx context.Context, dates durianpay.DateRange, result *ReconcileResult) error {
+	it := r.Refund.IterateRefunds(ctx, durianpay.RefundFetchOption{
+		Range: dates,
+		Limit: r.pageSize(),
+	})
+
+	for it.Next() {
+		rf := it.Item()
+		result.Refunds++
+		eventType := refundEventType(rf.Status)
+		if err := r.emit(ctx, "refund:"+rf.ID, rf.Status, eventType, &RefundEvent{Refunds: rf}, result); err != nil {
+			return err
+		}
+	}
+
+	return it.Err().Err()
+}
+
+func (r *Reconciler) reconcileDisbursementItems(ctx context.Context, ID string, result *ReconcileResult) error {
+	it := r.Disbursement.IterateItemsByID(ctx, ID, &durianpay.DisbursementFetchItemsOption{
+		Limit: r.pageSize(),
+	})
+
+	for it.Next() {
+		item := it.Item()
+		result.DisbursementItems++
+		eventType := disbursementItemEventType(item.Status)
+		if err := r.emit(ctx, "disbursement_item:"+item.ID, item.Status, eventType, &DisbursementItemEvent{DisbursementBatchItem: item}, result); err != nil {
+			return err
+		}
+	}
+
+	return it.Err().Err()
+}
+
+// emit calls OnEvent if status of key changed and eventType is known, then stores the status.
+func (r *Reconciler) emit(ctx context.Context, key, status string, eventType EventType, data any, result *ReconcileResult) error {
+	last, ok, err := r.State.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	if ok && last == status {
+		return nil
+	}
+
+	if eventType != "" && r.OnEvent != nil {
+		event, err := newSyntheticEvent(eventType, data)
+		if err != nil {
+			return err
+		}
+
+		if err := r.OnEvent(ctx, event); err != nil {
+			return err
+		}
+
+		result.Events++
+	}
+
+	return r.State.Set(ctx, key, status)
+}
+
+func (r *Reconciler) pageSize() uint16 {
+	if r.PageSize == 0 {
+		return defaultReconcilePageSize
+	}
+
+	return r.PageSize
+}
+
+// newSyntheticEvent returns Event with Raw body shaped like DurianPay webhook.
+func newSyntheticEvent(eventType EventType, data any) (*Event, error) {
+	event := &Event{
+		Type:      eventType,
+		Data:      data,
+		Synthetic: true,
+	}
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Raw = raw
+
+	return event, nil
+}
+
+func paymentEventType(status string) EventType {
+	switch strings.ToLower(status) {
+	case "completed", "success":
+		return EventPaymentCompleted
+	case "failed":
+		return EventPaymentFailed
+	case "expired", "cancelled":
+		return EventPaymentExpired
+	}
+
+	return ""
+}
+
+func refundEventType(status string) EventType {
+	switch strings.ToLower(status) {
+	case "done", "completed", "success":
+		return EventRefundCompleted
+	case "failed", "rejected":
+		return EventRefundFailed
+	}
+
+	return ""
+}
+
+func disbursementItemEventType(status string) EventType {
+	switch strings.ToLower(status) {
+	case "done", "completed", "success":
+		return EventDisbursementItemCompleted
+	case "failed", "invalid":
+		return EventDisbursementItemFailed
+	}
+
+	return ""
+}
+
+// MemoryReconcileCheckpointStore is in-memory ReconcileCheckpointStore.
+type MemoryReconcileCheckpointStore struct {
+	mu         sync.Mutex
+	checkpoint time.Time
+}
+
+func (s *MemoryReconcileCheckpointStore) Load(ctx context.Context) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.checkpoint, nil
+}
+
+func (s *MemoryReconcileCheckpointStore) Save(ctx context.Context, checkpoint time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.checkpoint = checkpoint
+
+	return nil
+}
+
+// FileReconcileCheckpointStore is ReconcileCheckpointStore persisted as RFC3339 time in file Path.
+type FileReconcileCheckpointStore struct {
+	Path string
+}
+
+func (s *FileReconcileCheckpointStore) Load(ctx context.Context) (time.Time, error) {
+	b, err := os.ReadFile(s.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return time.Time{}, nil
+	}
+
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Parse(time.RFC3339Nano, strings.TrimSpace(string(b)))
+}
+
+// Save writes checkpoint into temporary file then renames it, so the checkpoint is never partially written.
+func (s *FileReconcileCheckpointStore) Save(ctx context.Context, checkpoint time.Time) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(checkpoint.Format(time.RFC3339Nano)); err != nil {
+		tmp.Close()
+		return err
+	}
+
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.Path)
+}
+
+// MemoryStateStore is in-memory StateStore.
+type MemoryStateStore struct {
+	mu     sync.Mutex
+	states map[string]string
+}
+
+// NewMemoryStateStore returns empty MemoryStateStore.
+func NewMemoryStateStore() *MemoryStateStore {
+	return &MemoryStateStore{states: map[string]string{}}
+}
+
+func (s *MemoryStateStore) Get(ctx context.Context, key string) (string, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status, ok := s.states[key]
+
+	return status, ok, nil
+}
+
+func (s *MemoryStateStore) Set(ctx context.Context, key string, status string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.states[key] = status
+
+	return nil
+}
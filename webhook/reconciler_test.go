@@ -0,0 +1,237 @@
+/*
+ * File Created: Monday, 19th October 2026 12:30:08 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/internal/tests"
+	mock_common "github.com/abmid/dpay-sdk-go/internal/tests/mock"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+	"github.com/golang/mock/gomock"
+)
+
+// mockResponse returns DoAndReturn function which decodes body into response.
+func mockResponse(body string) func(ctx context.Context, method string, url string, param any, reqBody any, header map[string]string, response any) *durianpay.Error {
+	return func(ctx context.Context, method string, url string, param any, reqBody any, header map[string]string, response any) *durianpay.Error {
+		if err := json.Unmarshal([]byte(body), response); err != nil {
+			panic(err)
+		}
+
+		return nil
+	}
+}
+
+func TestReconciler_Run(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	dates := durianpay.NewDateRange(now.Add(-24*time.Hour), now)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	gomock.InOrder(
+		// First page of payments
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.PaymentFetchOption{Range: dates, Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_1","order_id":"ord_1","status":"completed"},{"id":"pay_2","order_id":"ord_2","status":"processing"}],"total":3}}`)),
+		// Second page of payments
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.PaymentFetchOption{Range: dates, Skip: 2, Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_3","order_id":"ord_3","status":"failed"}],"total":3}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), durianpay.RefundFetchOption{Range: dates, Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"refund":[{"id":"rfn_1","status":"done"}],"total_data":1}}`)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), &durianpay.DisbursementFetchItemsOption{Limit: 2}, nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"disbursement_batch_items":[{"id":"dis_item_1","status":"failed"}],"count":1}}`)),
+	)
+
+	state := NewMemoryStateStore()
+	state.Set(context.Background(), "payment:pay_3", "failed") // Already known, no event
+
+	checkpoint := &FileReconcileCheckpointStore{Path: filepath.Join(t.TempDir(), "checkpoint")}
+
+	var events []*Event
+	r := &Reconciler{
+		Payment:         &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Refund:          &refund.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Disbursement:    &disbursement.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		DisbursementIDs: []string{"dis_1"},
+		Checkpoint:      checkpoint,
+		State:           state,
+		PageSize:        2,
+		OnEvent: func(ctx context.Context, event *Event) error {
+			events = append(events, event)
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	result, err := r.Run(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wantResult := &ReconcileResult{Payments: 3, Refunds: 1, DisbursementItems: 1, Events: 3, Checkpoint: now}
+	if !reflect.DeepEqual(result, wantResult) {
+		t.Errorf("Reconciler.Run() = %+v, want %+v", result, wantResult)
+	}
+
+	var gotKeys []string
+	for _, e := range events {
+		if !e.Synthetic {
+			t.Errorf("event %s Synthetic = false, want true", e.Type)
+		}
+		gotKeys = append(gotKeys, EventKey(e))
+	}
+
+	wantKeys := []string{"payment.completed:pay_1", "refund.completed:rfn_1", "disbursement_item.failed:dis_item_1"}
+	if !reflect.DeepEqual(gotKeys, wantKeys) {
+		t.Errorf("Reconciler.Run() events = %v, want %v", gotKeys, wantKeys)
+	}
+
+	// Synthetic event body can be parsed like a real webhook
+	parsed, err := ParseEvent(events[0].Raw)
+	if err != nil || parsed.Data.(*PaymentEvent).OrderID != "ord_1" {
+		t.Errorf("ParseEvent(Raw) = %v, %v", parsed, err)
+	}
+
+	if got, _ := checkpoint.Load(context.Background()); !got.Equal(now) {
+		t.Errorf("checkpoint = %v, want %v", got, now)
+	}
+
+	if status, _, _ := state.Get(context.Background(), "payment:pay_2"); status != "processing" {
+		t.Errorf("state of pay_2 = %v, want processing", status)
+	}
+}
+
+func TestReconciler_Run_Overlap(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	now := time.Date(2023, 9, 5, 10, 0, 0, 0, time.UTC)
+	previous := now.Add(-time.Hour)
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+
+	// pay_1 was created before the previous run and completed after it, the range starts Overlap before the checkpoint
+	apiMock.EXPECT().
+		Req(gomock.Any(), "GET", gomock.Any(), durianpay.PaymentFetchOption{Range: durianpay.NewDateRange(previous.Add(-2*time.Hour), now), Limit: defaultReconcilePageSize}, nil, nil, gomock.Any()).
+		DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_1","order_id":"ord_1","status":"completed"}],"total":1}}`))
+
+	checkpoint := &MemoryReconcileCheckpointStore{}
+	checkpoint.Save(context.Background(), previous)
+
+	state := NewMemoryStateStore()
+	state.Set(context.Background(), "payment:pay_1", "processing") // Seen by the previous run
+
+	var events []*Event
+	r := &Reconciler{
+		Payment:    &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Checkpoint: checkpoint,
+		State:      state,
+		Overlap:    2 * time.Hour,
+		OnEvent: func(ctx context.Context, event *Event) error {
+			events = append(events, event)
+			return nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	if _, err := r.Run(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(events) != 1 || EventKey(events[0]) != "payment.completed:pay_1" {
+		t.Errorf("Reconciler.Run() events = %v, want payment.completed:pay_1", events)
+	}
+}
+
+func TestReconciler_Run_HandlerError(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	apiMock.EXPECT().
+		Req(gomock.Any(), "GET", gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+		DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_1","status":"completed"}],"total":1}}`))
+
+	checkpoint := &MemoryReconcileCheckpointStore{}
+	state := NewMemoryStateStore()
+
+	r := &Reconciler{
+		Payment:    &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Checkpoint: checkpoint,
+		State:      state,
+		OnEvent: func(ctx context.Context, event *Event) error {
+			return errors.New("handler down")
+		},
+	}
+
+	if _, err := r.Run(context.Background()); err == nil {
+		t.Fatal("Reconciler.Run() err = nil, want error")
+	}
+
+	if got, _ := checkpoint.Load(context.Background()); !got.IsZero() {
+		t.Errorf("checkpoint = %v, want not saved", got)
+	}
+
+	if _, ok, _ := state.Get(context.Background(), "payment:pay_1"); ok {
+		t.Errorf("state of pay_1 saved, want not saved so the event is emitted on the next run")
+	}
+}
+
+func TestReconciler_Run_SkipOverflow(t *testing.T) {
+	featureWrap := tests.FeatureWrap(t)
+	defer featureWrap.Ctrl.Finish()
+
+	// A full page of math.MaxUint16 payments, the following offsets cannot be sent as uint16 skip
+	payments := make([]string, math.MaxUint16)
+	for i := range payments {
+		payments[i] = fmt.Sprintf(`{"id":"pay_%d","status":"processing"}`, i)
+	}
+	firstPage := `{"data":{"payments":[` + strings.Join(payments, ",") + `],"total":70000}}`
+
+	apiMock := mock_common.NewMockApi(featureWrap.Ctrl)
+	gomock.InOrder(
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(firstPage)),
+		apiMock.EXPECT().
+			Req(gomock.Any(), "GET", gomock.Any(), gomock.Any(), nil, nil, gomock.Any()).
+			DoAndReturn(mockResponse(`{"data":{"payments":[{"id":"pay_last","status":"processing"}],"total":70000}}`)),
+	)
+
+	r := &Reconciler{
+		Payment:    &payment.Client{ServerKey: featureWrap.ServerKey, Api: apiMock},
+		Checkpoint: &MemoryReconcileCheckpointStore{},
+		State:      NewMemoryStateStore(),
+		PageSize:   math.MaxUint16,
+	}
+
+	// Skip wrapped to zero before, the reconciler fetched the first page forever
+	result, err := r.Run(context.Background())
+	if dpayErr, ok := durianpay.AsError(err); !ok || dpayErr.ErrorCode != durianpay.ErrorCodeSDK {
+		t.Errorf("Reconciler.Run() err = %v, want SDK error for skip above uint16", err)
+	}
+
+	if result.Payments != math.MaxUint16+1 {
+		t.Errorf("Reconciler.Run() payments = %v, want %v", result.Payments, math.MaxUint16+1)
+	}
+}
@@ -0,0 +1,465 @@
+/*
+ * File Created: Monday, 19th October 2026 12:32:52 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/invoice"
+	"github.com/abmid/dpay-sdk-go/order"
+	"github.com/abmid/dpay-sdk-go/payment"
+	"github.com/abmid/dpay-sdk-go/refund"
+	"github.com/abmid/dpay-sdk-go/virtualaccount"
+)
+
+// DeliveryMode is how Simulator.Deliver sends events.
+type DeliveryMode int
+
+const (
+	DeliverInOrder    DeliveryMode = iota // Every event once in the given order
+	DeliverDuplicate                      // Every event twice, like DurianPay retrying a delivered webhook
+	DeliverOutOfOrder                     // Every event once in shuffled order
+)
+
+// Delivery is result of delivering an event.
+type Delivery struct {
+	Event      *Event
+	StatusCode int
+	Err        error
+}
+
+// Simulator generates webhook payloads and delivers them to a local URL for development & testing.
+// Payment events are signed with ServerKey, see payment.Signature.
+type Simulator struct {
+	URL       string
+	ServerKey string
+	Client    *http.Client // Default http.DefaultClient
+	Rand      *rand.Rand   // Used for IDs & DeliverOutOfOrder, default seeded with current time
+
+	mu sync.Mutex
+}
+
+// NewSimulator returns Simulator delivering events to url.
+func NewSimulator(url, serverKey string) *Simulator {
+	return &Simulator{
+		URL:       url,
+		ServerKey: serverKey,
+	}
+}
+
+// AllEvents returns a sample event for every event type.
+func (s *Simulator) AllEvents() ([]*Event, error) {
+	builders := []func() (*Event, error){
+		func() (*Event, error) { return s.PaymentEvent(EventPaymentCompleted, nil) },
+		func() (*Event, error) { return s.PaymentEvent(EventPaymentFailed, nil) },
+		func() (*Event, error) { return s.PaymentEvent(EventPaymentExpired, nil) },
+		func() (*Event, error) { return s.OrderEvent(EventOrderCompleted, nil) },
+		func() (*Event, error) { return s.OrderEvent(EventOrderExpired, nil) },
+		func() (*Event, error) { return s.RefundEvent(EventRefundCompleted, nil) },
+		func() (*Event, error) { return s.RefundEvent(EventRefundFailed, nil) },
+		func() (*Event, error) { return s.DisbursementEvent(EventDisbursementCompleted, nil) },
+		func() (*Event, error) { return s.DisbursementEvent(EventDisbursementFailed, nil) },
+		func() (*Event, error) { return s.DisbursementItemEvent(EventDisbursementItemCompleted, nil) },
+		func() (*Event, error) { return s.DisbursementItemEvent(EventDisbursementItemFailed, nil) },
+		func() (*Event, error) { return s.VirtualAccountEvent(EventVirtualAccountPaid, nil) },
+		func() (*Event, error) { return s.InvoiceEvent(EventInvoicePaid, nil) },
+	}
+
+	events := make([]*Event, 0, len(builders))
+	for _, build := range builders {
+		event, err := build()
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+// PaymentEvent returns signed payment event derived from p, or sample payment if p is nil.
+func (s *Simulator) PaymentEvent(eventType EventType, p *payment.Payment) (*Event, error) {
+	now := time.Now().UTC()
+	data := &PaymentEvent{}
+
+	if p != nil {
+		data.Payments = payment.Payments{
+			ID:                 p.ID,
+			OrderID:            p.OrderID,
+			PaymentRefID:       p.PaymentRefID,
+			PaymentDsRefID:     p.PaymentDsRefID,
+			SettlementID:       p.SettlementID,
+			Amount:             p.Amount,
+			IsLive:             p.IsLive,
+			ExpirationDate:     p.ExpirationDate,
+			PaymentDetailsType: p.PaymentDetailsType,
+			MethodID:           p.MethodID,
+			CreatedAt:          p.CreatedAt,
+			UpdatedAt:          now,
+			Metadata:           p.Metadata,
+			Discount:           p.Discount,
+			PaidAmount:         p.PaidAmount,
+			PromoID:            p.PromoID,
+			ShippingFee:        p.ShippingFee,
+			CustomerID:         p.Customer.ID,
+			GivenName:          p.Customer.GivenName,
+			Email:              p.Customer.Email,
+			OrderRefID:         p.Order.OrderRefID,
+			Currency:           p.Order.Currency,
+			FailureReason:      p.FailureReason,
+		}
+	} else {
+		data.Payments = payment.Payments{
+			ID:                 s.id("pay_"),
+			OrderID:            s.id("ord_"),
+			PaymentRefID:       s.id("pay_ref_"),
+			Amount:             durianpay.NewAmount(10000),
+			PaymentDetailsType: "va_details",
+			MethodID:           "BCA",
+			ExpirationDate:     now.Add(24 * time.Hour),
+			CreatedAt:          now.Add(-time.Minute),
+			UpdatedAt:          now,
+			CustomerID:         s.id("cus_"),
+			GivenName:          "Jane Doe",
+			Email:              "jane_doe@nomail.com",
+			OrderRefID:         s.id("order_ref_"),
+			Currency:           "IDR",
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+	if eventType == EventPaymentCompleted && data.PaidAmount.IsZero() {
+		data.PaidAmount = data.Amount
+	}
+
+	data.Signature = payment.Signature(s.ServerKey, data.OrderID, data.ID)
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// OrderEvent returns order event derived from o, or sample order if o is nil.
+func (s *Simulator) OrderEvent(eventType EventType, o *order.Orders) (*Event, error) {
+	data := &OrderEvent{}
+
+	if o != nil {
+		data.Orders = *o
+	} else {
+		now := time.Now().UTC()
+		data.Orders = order.Orders{
+			ID:            s.id("ord_"),
+			CustomerID:    s.id("cus_"),
+			OrderRefID:    s.id("order_ref_"),
+			Amount:        durianpay.NewAmount(10000),
+			Currency:      "IDR",
+			CreatedAt:     now.Add(-time.Minute),
+			UpdatedAt:     now,
+			ExpiryDate:    now.Add(24 * time.Hour),
+			GivenName:     "Jane Doe",
+			Email:         "jane_doe@nomail.com",
+			Mobile:        "08123456789",
+			PaymentOption: "full_payment",
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// RefundEvent returns refund event derived from r, or sample refund if r is nil.
+func (s *Simulator) RefundEvent(eventType EventType, r *refund.Refunds) (*Event, error) {
+	data := &RefundEvent{}
+
+	if r != nil {
+		data.Refunds = *r
+	} else {
+		now := time.Now().UTC()
+		data.Refunds = refund.Refunds{
+			ID:                s.id("rfn_"),
+			MerchantID:        s.id("mer_"),
+			TotalAmount:       durianpay.NewAmount(10000),
+			CreatedAt:         now.Add(-time.Minute),
+			UpdatedAt:         now,
+			PaymentID:         s.id("pay_"),
+			RefundRefID:       s.id("refund_ref_"),
+			Type:              "normal",
+			CustomerID:        s.id("cus_"),
+			RefundPartial:     "full",
+			PaymentPaidAmount: durianpay.NewAmount(10000),
+			CustomerName:      "Jane Doe",
+			CustomerEmail:     "jane_doe@nomail.com",
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// DisbursementEvent returns disbursement event derived from d, or sample disbursement if d is nil.
+func (s *Simulator) DisbursementEvent(eventType EventType, d *disbursement.Disbursement) (*Event, error) {
+	data := &DisbursementEvent{}
+
+	if d != nil {
+		data.Disbursement = *d
+	} else {
+		data.Disbursement = disbursement.Disbursement{
+			ID:                 s.id("dis_"),
+			IdempotencyKey:     s.id(""),
+			Name:               "Salary",
+			Type:               "batch",
+			TotalAmount:        durianpay.NewAmount(20000),
+			TotalDisbursements: 2,
+			CreatedAt:          time.Now().UTC().Add(-time.Minute),
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// DisbursementItemEvent returns disbursement item event derived from item, or sample item if item is nil.
+func (s *Simulator) DisbursementItemEvent(eventType EventType, item *disbursement.DisbursementBatchItem) (*Event, error) {
+	data := &DisbursementItemEvent{}
+	now := time.Now().UTC()
+
+	if item != nil {
+		data.DisbursementBatchItem = *item
+	} else {
+		data.DisbursementBatchItem = disbursement.DisbursementBatchItem{
+			ID:                  s.id("dis_item_"),
+			DisbursementBatchID: s.id("dis_"),
+			AccountOwnerName:    "John Doe",
+			RealName:            "John Doe",
+			BankCode:            "bca",
+			Amount:              durianpay.NewAmount(10000),
+			AccountNumber:       "8422647",
+			EmailRecipient:      "john@nomail.com",
+			PhoneNumber:         "081234567890",
+			Notes:               "salary",
+			CreatedAt:           now.Add(-time.Minute),
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+	data.UpdatedAt = now
+	data.DisbursementStatusSetAt = now
+	if eventType == EventDisbursementItemFailed && data.FailureReson == "" {
+		data.FailureReson = "invalid account number"
+	}
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// VirtualAccountEvent returns VA paid event derived from va, or sample VA if va is nil.
+func (s *Simulator) VirtualAccountEvent(eventType EventType, va *virtualaccount.VirtualAccount) (*Event, error) {
+	now := time.Now().UTC()
+	data := &VirtualAccountEvent{
+		PaymentID: s.id("pay_"),
+		PaidAt:    now,
+	}
+
+	if va != nil {
+		data.VirtualAccount = *va
+	} else {
+		data.VirtualAccount = virtualaccount.VirtualAccount{
+			ID:            s.id("va_"),
+			BankCode:      "BCA",
+			AccountNumber: "1234567890",
+			Name:          "Jane Doe",
+			IsClosed:      true,
+			Amount:        durianpay.NewAmount(10000),
+			Currency:      "IDR",
+			CustomerID:    s.id("cus_"),
+			IsSandbox:     true,
+			CreatedAt:     now.Add(-time.Minute),
+			ExpiryAt:      now.Add(24 * time.Hour),
+			VaRefID:       s.id("va_ref_"),
+		}
+	}
+
+	data.IsPaid = true
+	data.PaidAmount = data.Amount
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// InvoiceEvent returns invoice paid event derived from inv, or sample invoice if inv is nil.
+func (s *Simulator) InvoiceEvent(eventType EventType, inv *invoice.Invoices) (*Event, error) {
+	now := time.Now().UTC()
+	data := &InvoiceEvent{
+		TransactionID: s.id("inv_txn_"),
+		PaidAt:        now,
+	}
+
+	if inv != nil {
+		data.Invoices = *inv
+	} else {
+		data.Invoices = invoice.Invoices{
+			ID:           s.id("inv_"),
+			InvoiceRefID: s.id("inv_ref_"),
+			CustomerID:   s.id("cus_"),
+			Title:        "Invoice",
+			Amount:       durianpay.NewAmount(10000),
+			StartDate:    now.Add(-time.Hour),
+			DueDate:      now.Add(24 * time.Hour),
+			CreatedAt:    now.Add(-time.Hour),
+		}
+	}
+
+	data.Status = eventStatus(eventType)
+	data.PaidAmount = data.Amount
+	data.RemainingAmount = durianpay.Amount{}
+
+	return newSimulatedEvent(eventType, data)
+}
+
+// Deliver sends events to URL based on mode and returns result of every delivery.
+// The returned error is only for failure to build the request, failed deliveries are reported in Delivery.Err.
+func (s *Simulator) Deliver(ctx context.Context, mode DeliveryMode, events ...*Event) ([]Delivery, error) {
+	ordered := make([]*Event, 0, len(events)*2)
+
+	switch mode {
+	case DeliverDuplicate:
+		for _, e := range events {
+			ordered = append(ordered, e, e)
+		}
+	case DeliverOutOfOrder:
+		ordered = append(ordered, events...)
+		s.shuffle(ordered)
+	default:
+		ordered = append(ordered, events...)
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	deliveries := make([]Delivery, 0, len(ordered))
+	for _, e := range ordered {
+		body := e.Raw
+		if body == nil {
+			var err error
+			if body, err = json.Marshal(e); err != nil {
+				return deliveries, err
+			}
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+		if err != nil {
+			return deliveries, err
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		delivery := Delivery{Event: e}
+
+		res, err := client.Do(req)
+		if err != nil {
+			delivery.Err = err
+		} else {
+			delivery.StatusCode = res.StatusCode
+			res.Body.Close()
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// shuffle shuffles events, the result always differs from the input when there are at least 2 events.
+func (s *Simulator) shuffle(events []*Event) {
+	if len(events) < 2 {
+		return
+	}
+
+	original := make([]*Event, len(events))
+	copy(original, events)
+
+	s.mu.Lock()
+	s.random().Shuffle(len(events), func(i, j int) { events[i], events[j] = events[j], events[i] })
+	s.mu.Unlock()
+
+	for i := range events {
+		if events[i] != original[i] {
+			return
+		}
+	}
+
+	events[0], events[len(events)-1] = events[len(events)-1], events[0]
+}
+
+// id returns random ID with prefix like DurianPay, ex: pay_pYQ319c4qo5956.
+func (s *Simulator) id(prefix string) string {
+	const chars = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r := s.random()
+
+	var b strings.Builder
+	b.WriteString(prefix)
+	for i := 0; i < 10; i++ {
+		b.WriteByte(chars[r.Intn(len(chars))])
+	}
+	fmt.Fprintf(&b, "%04d", r.Intn(10000))
+
+	return b.String()
+}
+
+func (s *Simulator) random() *rand.Rand {
+	if s.Rand == nil {
+		s.Rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	return s.Rand
+}
+
+// newSimulatedEvent returns Event with Raw body marshalled from data.
+func newSimulatedEvent(eventType EventType, data any) (*Event, error) {
+	event := &Event{
+		Type: eventType,
+		Data: data,
+	}
+
+	raw, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	event.Raw = raw
+
+	return event, nil
+}
+
+// eventStatus returns status of the resource for event type, ex: payment.completed returns completed.
+func eventStatus(eventType EventType) string {
+	_, status, _ := strings.Cut(string(eventType), ".")
+
+	switch {
+	case strings.HasPrefix(string(eventType), "refund.") && status == "completed":
+		return "done"
+	case strings.HasPrefix(string(eventType), "disbursement") && status == "completed":
+		return "success"
+	}
+
+	return status
+}
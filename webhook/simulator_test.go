@@ -0,0 +1,212 @@
+/*
+ * File Created: Monday, 19th October 2026 12:32:52 pm
+ * Author: Abdul Hamid (abdul.surel@gmail.com)
+ *
+ * Copyright (c) 2026 Author
+ */
+package webhook
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	durianpay "github.com/abmid/dpay-sdk-go"
+	"github.com/abmid/dpay-sdk-go/disbursement"
+	"github.com/abmid/dpay-sdk-go/payment"
+)
+
+// mustEvent returns event built by Simulator, it panics on error.
+func mustEvent(event *Event, err error) *Event {
+	if err != nil {
+		panic(err)
+	}
+
+	return event
+}
+
+func TestSimulator_AllEvents(t *testing.T) {
+	s := NewSimulator("", "dpay_test_xxx")
+	s.Rand = rand.New(rand.NewSource(1))
+
+	events, err := s.AllEvents()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	seen := map[EventType]bool{}
+	for _, e := range events {
+		seen[e.Type] = true
+
+		parsed, err := ParseEvent(e.Raw)
+		if err != nil {
+			t.Fatalf("ParseEvent(%s) error = %v", e.Type, err)
+		}
+
+		if parsed.Type != e.Type {
+			t.Errorf("ParseEvent() type = %v, want %v", parsed.Type, e.Type)
+		}
+
+		if data, ok := parsed.Data.(*PaymentEvent); ok {
+			if !data.VerifySignature("dpay_test_xxx") {
+				t.Errorf("%s signature is invalid", e.Type)
+			}
+			if data.Status != eventStatus(e.Type) {
+				t.Errorf("%s status = %v", e.Type, data.Status)
+			}
+		}
+	}
+
+	if len(seen) != 13 {
+		t.Errorf("AllEvents() covers %d event types, want 13", len(seen))
+	}
+}
+
+func TestSimulator_Derived(t *testing.T) {
+	s := NewSimulator("", "dpay_test_xxx")
+
+	p := &payment.Payment{
+		ID:      "pay_pYQ319c4qo5956",
+		OrderID: "ord_VN5nVJpSW27112",
+		Amount:  durianpay.MustParseAmount("20000.00"),
+	}
+	p.Order.OrderRefID = "order_ref_1"
+
+	event := mustEvent(s.PaymentEvent(EventPaymentCompleted, p))
+	data := event.Data.(*PaymentEvent)
+
+	if data.ID != p.ID || data.OrderRefID != "order_ref_1" || data.PaidAmount != durianpay.NewAmount(20000) || data.Status != "completed" {
+		t.Errorf("PaymentEvent() = %+v", data.Payments)
+	}
+
+	if data.Signature != "8450ccee779745741fa1c50ea5a438dd8564594bc5ece6ba20e91473e2db0e30" {
+		t.Errorf("PaymentEvent() signature = %v", data.Signature)
+	}
+
+	item := &disbursement.DisbursementBatchItem{ID: "dis_item_1", Amount: durianpay.NewAmount(10000)}
+	itemEvent := mustEvent(s.DisbursementItemEvent(EventDisbursementItemFailed, item)).Data.(*DisbursementItemEvent)
+
+	if itemEvent.ID != "dis_item_1" || itemEvent.Status != "failed" || itemEvent.FailureReson == "" {
+		t.Errorf("DisbursementItemEvent() = %+v", itemEvent.DisbursementBatchItem)
+	}
+
+	if item.Status != "" {
+		t.Errorf("DisbursementItemEvent() should not modify item")
+	}
+}
+
+func TestSimulator_Deliver(t *testing.T) {
+	var mu sync.Mutex
+	var received []EventType
+
+	server := httptest.NewServer(NewHandler(func(ctx context.Context, event *Event) error {
+		mu.Lock()
+		defer mu.Unlock()
+		received = append(received, event.Type)
+		return nil
+	}))
+	defer server.Close()
+
+	s := NewSimulator(server.URL, "dpay_test_xxx")
+	s.Rand = rand.New(rand.NewSource(1))
+
+	events := []*Event{
+		mustEvent(s.PaymentEvent(EventPaymentCompleted, nil)),
+		mustEvent(s.RefundEvent(EventRefundCompleted, nil)),
+		mustEvent(s.InvoiceEvent(EventInvoicePaid, nil)),
+	}
+
+	tests := []struct {
+		name  string
+		mode  DeliveryMode
+		check func(t *testing.T, got []EventType)
+	}{
+		{
+			name: "In order",
+			mode: DeliverInOrder,
+			check: func(t *testing.T, got []EventType) {
+				want := []EventType{EventPaymentCompleted, EventRefundCompleted, EventInvoicePaid}
+				if !equalEventTypes(got, want) {
+					t.Errorf("received = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "Duplicate",
+			mode: DeliverDuplicate,
+			check: func(t *testing.T, got []EventType) {
+				want := []EventType{EventPaymentCompleted, EventPaymentCompleted, EventRefundCompleted, EventRefundCompleted, EventInvoicePaid, EventInvoicePaid}
+				if !equalEventTypes(got, want) {
+					t.Errorf("received = %v, want %v", got, want)
+				}
+			},
+		},
+		{
+			name: "Out of order",
+			mode: DeliverOutOfOrder,
+			check: func(t *testing.T, got []EventType) {
+				if len(got) != 3 {
+					t.Fatalf("received %d events, want 3", len(got))
+				}
+				if equalEventTypes(got, []EventType{EventPaymentCompleted, EventRefundCompleted, EventInvoicePaid}) {
+					t.Errorf("received = %v, want shuffled", got)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			received = nil
+
+			deliveries, err := s.Deliver(context.Background(), tt.mode, events...)
+			if err != nil {
+				t.Fatalf("Deliver() error = %v", err)
+			}
+
+			for _, d := range deliveries {
+				if d.Err != nil || d.StatusCode != http.StatusOK {
+					t.Errorf("Deliver() %s = %d, %v", d.Event.Type, d.StatusCode, d.Err)
+				}
+			}
+
+			tt.check(t, received)
+		})
+	}
+}
+
+func TestSimulator_Deliver_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+	}))
+	server.Close()
+
+	s := NewSimulator(server.URL, "dpay_test_xxx")
+
+	deliveries, err := s.Deliver(context.Background(), DeliverInOrder, mustEvent(s.OrderEvent(EventOrderCompleted, nil)))
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+
+	if len(deliveries) != 1 || deliveries[0].Err == nil {
+		t.Errorf("Deliver() = %+v, want delivery error", deliveries)
+	}
+}
+
+func equalEventTypes(a, b []EventType) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}